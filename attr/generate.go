@@ -32,7 +32,7 @@ var altDoc = map[string]string{
 When your component shuffles around during render passes, it might be destroyed 
 and recreated due to the diff algorithm. Assigning it a key that persists makes 
 sure the component stays.`,
-	"ref": "Ref adds an ref to a component, see http://facebook.github.io/react/docs/more-about-refs.html",
+	"ref": "Ref adds a ref to a component, typically a *gr.Ref, see https://reactjs.org/docs/refs-and-the-dom.html",
 	"dangerouslySetInnerHTML": `DangerouslySetInnerHTML Provides the ability to insert raw HTML, 
 mainly for cooperating with DOM string manipulation libraries.`,
 	"defaultValue": `DefaultValue can be used to initialize an uncontrolled React component with a non-empty value.
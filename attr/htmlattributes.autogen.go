@@ -525,7 +525,7 @@ func ReadOnly(v interface{}) gr.Modifier {
 	return gr.Prop("readOnly", v)
 }
 
-// Ref adds an ref to a component, see http://facebook.github.io/react/docs/more-about-refs.html
+// Ref adds a ref to a component, typically a *gr.Ref, see https://reactjs.org/docs/refs-and-the-dom.html
 func Ref(v interface{}) gr.Modifier {
 	return gr.Prop("ref", v)
 }
@@ -0,0 +1,114 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"fmt"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ErrorInfo holds additional information about an error caught by an error boundary.
+type ErrorInfo struct {
+	// ComponentStack is the component stack trace at the point the error was thrown.
+	ComponentStack string
+}
+
+// wireErrorBoundary sets up the component as an error boundary, if it implements
+// ComponentDidCatch and/or ErrorBoundary and the React version supports it.
+func wireErrorBoundary(rc *reactClass, ts ThisSetter, r Renderer) {
+	if !version.atLeast(16, 0) {
+		return
+	}
+
+	didCatch, hasDidCatch := r.(ComponentDidCatch)
+	boundary, isBoundary := r.(ErrorBoundary)
+
+	if !hasDidCatch && !isBoundary {
+		return
+	}
+
+	// getDerivedStateFromError arrived in React 16.6; for older versions we
+	// emulate it with a setState in componentDidCatch.
+	hasDerivedState := isBoundary && version.atLeast(16, 6)
+
+	if hasDerivedState {
+		rc.setStatic("getDerivedStateFromError", js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			return boundary.GetDerivedStateFromError(errorFromArgs(arguments))
+		}))
+	}
+
+	rc.componentDidCatch = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		if ts != nil {
+			ts.SetThis(this)
+		}
+
+		err := errorFromArgs(arguments)
+
+		if isBoundary && !hasDerivedState {
+			this.Call("setState", boundary.GetDerivedStateFromError(err))
+		}
+
+		if hasDidCatch {
+			var info ErrorInfo
+			if len(arguments) > 1 && !isNullOrUndefined(arguments[1]) {
+				if stack := arguments[1].Get("componentStack"); stack != js.Undefined {
+					info.ComponentStack = stack.String()
+				}
+			}
+			didCatch.ComponentDidCatch(err, info)
+		}
+
+		return nil
+	})
+}
+
+func errorFromArgs(arguments []*js.Object) error {
+	if len(arguments) == 0 || isNullOrUndefined(arguments[0]) {
+		return &js.Error{Object: js.Global.Get("Error").New("unknown error")}
+	}
+	return &js.Error{Object: arguments[0]}
+}
+
+// toJSError converts a value recovered from a Go panic into a JavaScript error.
+// GopherJS throws the wrapped JavaScript error as-is when panicking with a *js.Error,
+// which makes it possible for React's error boundaries to catch it.
+func toJSError(r interface{}, where string) *js.Error {
+	if err, ok := r.(*js.Error); ok {
+		return err
+	}
+	return &js.Error{Object: js.Global.Get("Error").New(fmt.Sprintf("%s: %v", where, r))}
+}
+
+// rethrowAsJSError is meant to be deferred in funcs called from React.
+func rethrowAsJSError(where string) {
+	if r := recover(); r != nil {
+		panic(toJSError(r, where))
+	}
+}
+
+// throwInRender makes React throw the error during the next render of the
+// component, so the closest error boundary can catch it. React's error boundaries
+// do not catch errors thrown in event handlers.
+func throwInRender(that *This, err *js.Error) {
+	if that == nil || isNullOrUndefined(that.This) {
+		panic(err)
+	}
+	that.This.Call("setState", js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		panic(err)
+	}))
+}
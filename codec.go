@@ -0,0 +1,402 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// This file contains the mapping between Go structs and the JavaScript objects
+// used as props and state.
+//
+// Struct fields are mapped by the name given in the gr struct tag, falling back
+// to the field name:
+//
+//    type gist struct {
+//        ID          string    `gr:"id"`
+//        Description string    `gr:"description,omitempty"`
+//        CreatedAt   time.Time `gr:"createdAt"`
+//        Internal    string    `gr:"-"`
+//    }
+//
+// Nested structs, pointers, slices, arrays and maps with string keys are supported.
+// A time.Time is stored as a JavaScript Date, the zero time as null.
+
+const structTagName = "gr"
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	jsObjectType = reflect.TypeOf((*js.Object)(nil))
+)
+
+// PropsFrom creates Props from the given struct (or pointer to struct) using
+// the gr struct tags.
+func PropsFrom(v interface{}) (Props, error) {
+	m, err := structToMap(v)
+	if err != nil {
+		return nil, err
+	}
+	return Props(m), nil
+}
+
+// StateFrom creates State from the given struct (or pointer to struct) using
+// the gr struct tags.
+func StateFrom(v interface{}) (State, error) {
+	m, err := structToMap(v)
+	if err != nil {
+		return nil, err
+	}
+	return State(nodeProps(m)), nil
+}
+
+// Into decodes the props into the struct pointed to by v using the gr struct tags.
+func (p Props) Into(v interface{}) error {
+	return decodeMap(p, v)
+}
+
+// Into decodes the state into the struct pointed to by v using the gr struct tags.
+func (s State) Into(v interface{}) error {
+	return decodeMap(s, v)
+}
+
+// PropsInto decodes this.props into the struct pointed to by v using the gr struct tags.
+func (t *This) PropsInto(v interface{}) error {
+	return decodeObject(t.This.Get("props"), v)
+}
+
+// StateInto decodes this.state into the struct pointed to by v using the gr struct tags.
+func (t *This) StateInto(v interface{}) error {
+	return decodeObject(t.This.Get("state"), v)
+}
+
+// SetStateFrom sets the state from the given struct (or pointer to struct) using
+// the gr struct tags.
+func (t *This) SetStateFrom(v interface{}) error {
+	s, err := StateFrom(v)
+	if err != nil {
+		return err
+	}
+	t.SetState(s)
+	return nil
+}
+
+func structToMap(v interface{}) (map[string]interface{}, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct || rv.Type() == timeType {
+		return nil, fmt.Errorf("Expected a struct, got %T", v)
+	}
+
+	encoded, err := encodeValue(rv)
+	if err != nil {
+		return nil, err
+	}
+
+	return encoded.(map[string]interface{}), nil
+}
+
+func decodeObject(o *js.Object, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("Expected a non-nil pointer, got %T", v)
+	}
+	if isNullOrUndefined(o) {
+		return nil
+	}
+	return decodeValue(o, rv.Elem())
+}
+
+func decodeMap(m map[string]interface{}, v interface{}) error {
+	o := js.Global.Get("Object").New()
+	for k, val := range m {
+		o.Set(k, val)
+	}
+	return decodeObject(o, v)
+}
+
+type fieldInfo struct {
+	name      string
+	omitEmpty bool
+	required  bool
+	index     int
+}
+
+func structFields(t reflect.Type) []fieldInfo {
+	var fields []fieldInfo
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// Unexported
+			continue
+		}
+
+		tag := f.Tag.Get(structTagName)
+		if tag == "-" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		fi := fieldInfo{name: parts[0], index: i}
+
+		if fi.name == "" {
+			fi.name = f.Name
+		}
+
+		for _, opt := range parts[1:] {
+			switch opt {
+			case "omitempty":
+				fi.omitEmpty = true
+			case "required":
+				fi.required = true
+			}
+		}
+
+		fields = append(fields, fi)
+	}
+
+	return fields
+}
+
+func encodeValue(v reflect.Value) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil, nil
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+		if o, ok := v.Interface().(*js.Object); ok {
+			return o, nil
+		}
+		if c, ok := propComponent(v.Interface()); ok {
+			// Converted to a React node when the element is created, see nodeProps.
+			return c, nil
+		}
+		return encodeValue(v.Elem())
+	case reflect.Struct:
+		if v.Type() == timeType {
+			t := v.Interface().(time.Time)
+			if t.IsZero() {
+				return nil, nil
+			}
+			// UnixNano overflows outside the years 1678 to 2262.
+			return js.Global.Get("Date").New(float64(t.Unix()*1000 + int64(t.Nanosecond())/1e6)), nil
+		}
+		m := make(map[string]interface{})
+		for _, f := range structFields(v.Type()) {
+			fv := v.Field(f.index)
+			if f.omitEmpty && isEmptyValue(fv) {
+				continue
+			}
+			ev, err := encodeValue(fv)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", f.name, err)
+			}
+			m[f.name] = ev
+		}
+		return m, nil
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil, nil
+		}
+		s := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			ev, err := encodeValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			s[i] = ev
+		}
+		return s, nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("Unsupported map key type %s", v.Type().Key())
+		}
+		if v.IsNil() {
+			return nil, nil
+		}
+		m := make(map[string]interface{})
+		for _, k := range v.MapKeys() {
+			ev, err := encodeValue(v.MapIndex(k))
+			if err != nil {
+				return nil, err
+			}
+			m[k.String()] = ev
+		}
+		return m, nil
+	case reflect.Chan, reflect.UnsafePointer, reflect.Complex64, reflect.Complex128:
+		return nil, fmt.Errorf("Unsupported type %s", v.Type())
+	default:
+		// Basic types and funcs are handled by GopherJS.
+		return v.Interface(), nil
+	}
+}
+
+func decodeValue(o *js.Object, v reflect.Value) error {
+	if isNullOrUndefined(o) {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(o.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(o.Int64())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		v.SetUint(o.Uint64())
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(o.Float())
+	case reflect.String:
+		v.SetString(o.String())
+	case reflect.Ptr:
+		if v.Type() == jsObjectType {
+			v.Set(reflect.ValueOf(o))
+			return nil
+		}
+		if v.Type() == elementType {
+			v.Set(reflect.ValueOf(NewPreparedElement(o)))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return decodeValue(o, v.Elem())
+	case reflect.Interface:
+		if v.Type() == componentType {
+			v.Set(reflect.ValueOf(NewPreparedElement(o)))
+			return nil
+		}
+		if v.NumMethod() != 0 {
+			return fmt.Errorf("Unsupported interface type %s", v.Type())
+		}
+		if i := o.Interface(); i != nil {
+			v.Set(reflect.ValueOf(i))
+		}
+	case reflect.Struct:
+		if v.Type() == timeType {
+			t, err := decodeTime(o)
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(t))
+			return nil
+		}
+		for _, f := range structFields(v.Type()) {
+			fo := o.Get(f.name)
+			if fo == js.Undefined {
+				continue
+			}
+			if err := decodeValue(fo, v.Field(f.index)); err != nil {
+				return fmt.Errorf("%s: %s", f.name, err)
+			}
+		}
+	case reflect.Slice:
+		n := o.Length()
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			if err := decodeValue(o.Index(i), s.Index(i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+	case reflect.Array:
+		n := o.Length()
+		for i := 0; i < v.Len() && i < n; i++ {
+			if err := decodeValue(o.Index(i), v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("Unsupported map key type %s", v.Type().Key())
+		}
+		m := reflect.MakeMap(v.Type())
+		for _, k := range js.Keys(o) {
+			ev := reflect.New(v.Type().Elem()).Elem()
+			if err := decodeValue(o.Get(k), ev); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(k).Convert(v.Type().Key()), ev)
+		}
+		v.Set(m)
+	case reflect.Func:
+		f := reflect.ValueOf(o.Interface())
+		if !f.IsValid() || !f.Type().ConvertibleTo(v.Type()) {
+			return fmt.Errorf("Cannot decode func into %s", v.Type())
+		}
+		v.Set(f.Convert(v.Type()))
+	default:
+		return fmt.Errorf("Unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+func decodeTime(o *js.Object) (time.Time, error) {
+	if o.Get("getTime") != js.Undefined {
+		// A JavaScript Date
+		return msToTime(o.Call("getTime").Float()), nil
+	}
+
+	switch v := o.Interface().(type) {
+	case float64:
+		return msToTime(v), nil
+	case string:
+		return time.Parse(time.RFC3339Nano, v)
+	}
+
+	return time.Time{}, errors.New("Cannot decode time")
+}
+
+func msToTime(ms float64) time.Time {
+	sec := math.Floor(ms / 1000)
+	return time.Unix(int64(sec), int64((ms-sec*1000)*1e6))
+}
+
+func isNullOrUndefined(o *js.Object) bool {
+	return o == nil || o == js.Undefined
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).IsZero()
+		}
+	}
+	return false
+}
@@ -43,6 +43,8 @@ func init() {
 			panic(fmt.Sprintf("Cannot find ReactDOM"))
 		}
 	}
+
+	initReactVersion()
 }
 
 // A Component represents a React JS component.
@@ -66,7 +68,7 @@ type Factory interface {
 // This component can either be constructed from a Go implementation (see New) or
 // loaded from JavaScript (see FromGlobal and Require).
 type ReactComponent struct {
-	// The React.createClass response.
+	// The element factory for the React class created.
 	node *js.Object
 
 	// Prototype cached for the cloning purpose.
@@ -82,6 +84,14 @@ type ReactComponent struct {
 	globalName      string
 	componentConfig ComponentConfig
 
+	// How props and state are compared in shouldComponentUpdate, see Pure.
+	compare compareMode
+
+	// Whether this is a function component, see NewFunc, and whether it is
+	// memoized, see Memo.
+	funcComponent bool
+	memo          bool
+
 	// Needs to be created by createElement as opposed to standalone React factories.
 	// TODO(bep) figure a way to extract that info from the JS object.
 	needsCreate bool
@@ -166,6 +176,19 @@ func Global(name string) Option {
 	}}
 }
 
+// DisplayName is an option used to set the name of the component as shown in
+// React's debug messages and developer tools. The default is the Go type name.
+func DisplayName(name string) Option {
+	// This needs to run before createClass
+	return Option{preparePhase: true, action: func(r *ReactComponent) error {
+		if name == "" {
+			return errors.New("Must provide display name")
+		}
+		r.reactClass.displayName = name
+		return nil
+	}}
+}
+
 // Apply the func to the newly created React component.
 func Apply(f func(o *js.Object) *js.Object) Option {
 	return Option{action: func(r *ReactComponent) error {
@@ -203,6 +226,7 @@ type reactClass struct {
 
 	render            *js.Object `js:"render"`
 	getDefaultProps   *js.Object `js:"getDefaultProps"`
+	propTypes         js.M       `js:"propTypes"`
 	getInitialState   *js.Object `js:"getInitialState"`
 	getChildContext   *js.Object `js:"getChildContext"`
 	childContextTypes js.M       `js:"childContextTypes"`
@@ -215,6 +239,16 @@ type reactClass struct {
 	componentWillMount        *js.Object `js:"componentWillMount"`
 	componentDidMount         *js.Object `js:"componentDidMount"`
 	componentWillUnmount      *js.Object `js:"componentWillUnmount"`
+	componentDidCatch         *js.Object `js:"componentDidCatch"`
+
+	statics *js.Object `js:"statics"`
+}
+
+func (c *reactClass) setStatic(name string, v interface{}) {
+	if isNullOrUndefined(c.statics) {
+		c.statics = js.Global.Get("Object").New()
+	}
+	c.statics.Set(name, v)
 }
 
 type delegateRenderer struct {
@@ -242,7 +276,6 @@ func New(r Renderer, options ...Option) *ReactComponent {
 	root.reactClass.displayName = displayName
 
 	// TODO(bep)
-	// getDefaultProps propTypes https://github.com/bep/gr/issues/23
 	// mixins https://github.com/bep/gr/issues/24
 	// statics  https://github.com/bep/gr/issues/25
 
@@ -261,6 +294,18 @@ func New(r Renderer, options ...Option) *ReactComponent {
 		})
 	}
 
+	if v, ok := r.(DefaultPropsProvider); ok {
+		root.reactClass.getDefaultProps = makeDefaultPropsFunc(v.GetDefaultProps)
+	}
+
+	if v, ok := r.(PropTypesProvider); ok {
+		propTypes, err := propTypesFromTemplate(v.PropTypes())
+		if err != nil {
+			panic(err)
+		}
+		root.reactClass.propTypes = propTypes
+	}
+
 	if v, ok := r.(ChildContextProvider); ok {
 		root.reactClass.getChildContext, root.reactClass.childContextTypes = makeChildContextFunc(ts, v.GetChildContext)
 	}
@@ -285,39 +330,30 @@ func New(r Renderer, options ...Option) *ReactComponent {
 		root.reactClass.componentWillMount = makeVoidFunc(ts, v.ComponentWillMount, true)
 	}
 
+	// These are always set, as we need to track the mount state.
+	var didMount func()
 	if v, ok := r.(ComponentDidMount); ok {
-		root.reactClass.componentDidMount = makeVoidFunc(ts, v.ComponentDidMount, true)
+		didMount = v.ComponentDidMount
 	}
+	root.reactClass.componentDidMount = makeMountFunc(ts, didMount)
 
+	var willUnmount func()
 	if v, ok := r.(ComponentWillUnmount); ok {
-		root.reactClass.componentWillUnmount = makeVoidFunc(ts, v.ComponentWillUnmount, true)
+		willUnmount = v.ComponentWillUnmount
 	}
+	root.reactClass.componentWillUnmount = makeUnmountFunc(ts, willUnmount)
 
-	for _, opt := range options {
-		if !opt.preparePhase {
-			continue
-		}
-		err := opt.action(root)
-		if err != nil {
-			panic(err)
-		}
-	}
+	wireErrorBoundary(root.reactClass, ts, r)
+
+	root.applyOptions(options, true)
 
 	root.handleOptionsOnPrepare()
 
-	class := react.Call("createClass", root.reactClass.Object)
+	class := createClass(root.reactClass.Object)
 
-	root.node = react.Call("createFactory", class)
+	root.node = createFactory(class)
 
-	for _, opt := range options {
-		if opt.preparePhase {
-			continue
-		}
-		err := opt.action(root)
-		if err != nil {
-			panic(err)
-		}
-	}
+	root.applyOptions(options, false)
 
 	root.handleOptionsOnCreate()
 
@@ -352,6 +388,22 @@ func (r *ReactComponent) Render(elementID string, props Props) {
 	reactDOM.Call("render", elem.Node(), container)
 }
 
+// Hydrate attaches the Component to server rendered markup (see RenderToString) in the
+// DOM element with the given ID. The props should be the same as those used on the server,
+// see PropsFromScript for one way to ship them.
+// For React versions without ReactDOM.hydrate this falls back to Render.
+func (r *ReactComponent) Hydrate(elementID string, props Props) {
+	if reactDOM.Get("hydrate") == js.Undefined {
+		r.Render(elementID, props)
+		return
+	}
+
+	container := js.Global.Get("document").Call("getElementById", elementID)
+	elem := r.CreateElement(props)
+
+	reactDOM.Call("hydrate", elem.Node(), container)
+}
+
 // CreateElement implements the Factory interface.
 // TODO(bep) consolidate and clean
 func (r *ReactComponent) CreateElement(props Props, children ...Component) *Element {
@@ -398,7 +450,7 @@ func cloneElement(prototype *js.Object, e *Element) *js.Object {
 		}
 	}
 
-	elem = react.Call("cloneElement", prototype, e.properties, args)
+	elem = react.Call("cloneElement", prototype, nodeProps(e.properties), args)
 
 	return elem
 }
@@ -415,10 +467,10 @@ func createOrInvoke(node *js.Object, e *Element, needsCreate bool) *js.Object {
 	}
 
 	if needsCreate {
-		elem = react.Call("createElement", node, e.properties, args)
+		elem = react.Call("createElement", node, nodeProps(e.properties), args)
 	} else {
 
-		elem = node.Invoke(e.properties, args)
+		elem = node.Invoke(nodeProps(e.properties), args)
 	}
 	return elem
 }
@@ -525,6 +577,48 @@ func makeVoidFunc(ts ThisSetter, f func(), assumeBlocking bool) *js.Object {
 	})
 }
 
+func makeMountFunc(ts ThisSetter, f func()) *js.Object {
+	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		// React 18's StrictMode unmounts and mounts the same instance again.
+		markMounted(this)
+
+		if f == nil {
+			return nil
+		}
+
+		if ts != nil {
+			ts.SetThis(this)
+		}
+
+		go func() {
+			f()
+		}()
+
+		return nil
+	})
+}
+
+func makeUnmountFunc(ts ThisSetter, f func()) *js.Object {
+	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		// This must be done before any goroutine is started.
+		markUnmounted(this)
+
+		if f == nil {
+			return nil
+		}
+
+		if ts != nil {
+			ts.SetThis(this)
+		}
+
+		go func() {
+			f()
+		}()
+
+		return nil
+	})
+}
+
 func makeStateFunc(ts ThisSetter, f func() State) *js.Object {
 	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
 		if ts != nil {
@@ -534,6 +628,14 @@ func makeStateFunc(ts ThisSetter, f func() State) *js.Object {
 	})
 }
 
+// getDefaultProps is invoked once when the class is created and cached,
+// so there is no this to set.
+func makeDefaultPropsFunc(f func() Props) *js.Object {
+	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		return f()
+	})
+}
+
 func makeChildContextFunc(ts ThisSetter, f func() Context) (*js.Object, js.M) {
 
 	getChildContext := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
@@ -548,25 +650,6 @@ func makeChildContextFunc(ts ThisSetter, f func() Context) (*js.Object, js.M) {
 	return getChildContext, childContextTypes
 }
 
-func extractPropTypesFromTemplate(t map[string]interface{}) js.M {
-	propTypes := js.M{}
-
-	for k, v := range t {
-		switch v.(type) {
-		case string:
-			propTypes[k] = react.Get("PropTypes").Get("string")
-		case int:
-			propTypes[k] = react.Get("PropTypes").Get("number")
-		default:
-			// See: https://facebook.github.io/react/docs/reusable-components.html
-			// TODO(bep): Reconsider all of this.
-			panic("Context type not implemented")
-		}
-	}
-
-	return propTypes
-}
-
 type incrementer struct {
 	counter int
 }
@@ -579,38 +662,61 @@ func (i *incrementer) next() int {
 func makeRenderFunc(ts ThisSetter, s string, f func() Component) *js.Object {
 
 	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		// Make Go panics catchable by error boundaries.
+		defer rethrowAsJSError(s + ".Render")
+
 		if ts != nil {
 			ts.SetThis(this)
 		}
 
-		comp := f()
-
-		if comp == nil {
-			return nil
-		}
+		return prepareRendered(ts, s, f(), NewThis(this))
+	})
+}
 
-		that := NewThis(this)
+// prepareRendered prepares the Component returned from a render func for React.
+func prepareRendered(ts ThisSetter, s string, comp Component, that *This) *js.Object {
+	if comp == nil {
+		return nil
+	}
 
-		// TODO(bep) refactor
-		if e, ok := comp.(*Element); ok {
-			addEventListeners(ts, comp, that)
-			idFactory := &incrementer{}
-			addMissingKeys(s, e, idFactory)
-		}
-		if _, ok := comp.(Factory); ok {
-			panic("Render should return a ready-to-use Element.")
-		}
+	// TODO(bep) refactor
+	if e, ok := comp.(*Element); ok {
+		addEventListeners(ts, comp, that)
+		idFactory := &incrementer{}
+		addMissingKeys(s, e, idFactory)
+	}
+	if _, ok := comp.(Factory); ok {
+		panic("Render should return a ready-to-use Element.")
+	}
 
-		return comp.Node()
-	})
+	return comp.Node()
 }
 
 func addEventListeners(ts ThisSetter, c Component, that *This) {
 	if e, ok := c.(*Element); ok {
-		for _, l := range e.eventListeners {
+		if e.isVirtual() && len(e.eventListeners) > 0 {
+			panic("Event listeners cannot be attached to a Fragment, a Portal or a context element, attach them to its children")
+		}
+		if e.consume != nil {
+			// The Consumer renders later, in its own callback from React.
+			e.owner, e.ownerSetter = that, ts
+		}
+		// Multiple listeners for the same event on an element are notified in order.
+		delegates := make(map[string][]func(*js.Object))
+
+		for i, l := range e.eventListeners {
+			l := l
+			id := fmt.Sprintf("%s-%d", l.propName(), i)
 			l.delegate = func(event *js.Object) {
-				if ts != nil {
-					ts.SetThis(that.This)
+				var state *listenerState
+				if l.once || l.wait > 0 {
+					state = listenerStateFor(event, id, &l.state)
+				}
+				if l.once {
+					if state.fired {
+						return
+					}
+					state.fired = true
 				}
 				if l.preventDefault {
 					event.Call("preventDefault")
@@ -618,16 +724,54 @@ func addEventListeners(ts ThisSetter, c Component, that *This) {
 				if l.stopPropagation {
 					event.Call("stopPropagation")
 				}
-				l.listener(&Event{Object: event, This: that})
+				l.schedule(state, event, that, func() {
+					defer func() {
+						if r := recover(); r != nil {
+							throwInRender(that, toJSError(r, l.name))
+						}
+					}()
+					if ts != nil {
+						ts.SetThis(that.This)
+					}
+					l.listener(&Event{Object: event, This: that})
+				})
 			}
 
-			e.properties[l.name] = l.delegate
+			delegates[l.propName()] = append(delegates[l.propName()], l.delegate)
+		}
 
+		for name, ds := range delegates {
+			if len(ds) == 1 {
+				e.properties[name] = ds[0]
+				continue
+			}
+			ds := ds
+			e.properties[name] = func(event *js.Object) {
+				for _, d := range ds {
+					d(event)
+				}
+			}
 		}
+
 		for _, child := range e.children {
 			addEventListeners(ts, child, that)
 		}
 
+		propElements(e.properties, func(e2 *Element) {
+			addEventListeners(ts, e2, that)
+		})
+	}
+}
+
+func (r *ReactComponent) applyOptions(options []Option, preparePhase bool) {
+	for _, opt := range options {
+		if opt.preparePhase != preparePhase {
+			continue
+		}
+		err := opt.action(r)
+		if err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -649,6 +793,12 @@ func (r *ReactComponent) handleOptionsOnPrepare() {
 	if r.componentConfig.ContextTypesTemplate != nil {
 		r.reactClass.contextTypes = extractPropTypesFromTemplate(r.componentConfig.ContextTypesTemplate)
 	}
+	if r.compare != compareNone && !r.funcComponent {
+		if r.reactClass.shouldComponentUpdate != nil {
+			panic("Pure cannot be combined with ShouldComponentUpdate")
+		}
+		r.reactClass.shouldComponentUpdate = makePureUpdateFunc(r.compare)
+	}
 }
 
 func addMissingKeys(s string, e *Element, id *incrementer) {
@@ -663,9 +813,22 @@ func addMissingKeys(s string, e *Element, id *incrementer) {
 		}
 	}
 
+	if e.consume != nil {
+		e.consumePrefix = fmt.Sprintf("%s-consumer-%d", s, id.next())
+	}
+
+	if e.keyed {
+		// Make the keys in a list item independent of its position in the list.
+		id = &incrementer{}
+	}
+
 	for _, c2 := range e.children {
 		if e2, ok := c2.(*Element); ok {
 			addMissingKeys(s, e2, id)
 		}
 	}
+
+	propElements(e.properties, func(e2 *Element) {
+		addMissingKeys(s, e2, id)
+	})
 }
@@ -0,0 +1,133 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"errors"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ContextType is a React context that can hold any Go value, e.g. a store, a theme or
+// an API client, and share it with all the components below the Provider in the tree.
+// This replaces the legacy context, see ChildContextProvider, which is deprecated in React
+// and only supports simple types.
+//
+// The value is read with UseContext in function components, and with ContextOf or a
+// Consumer in class components.
+// ContextType requires React 16.3 or newer.
+//
+// See https://reactjs.org/docs/context.html
+type ContextType struct {
+	*js.Object
+
+	// The last provided value and its wrapper. React compares context values
+	// by identity, so reusing the wrapper saves needless re-renders.
+	value   interface{}
+	wrapped interface{}
+}
+
+// CreateContext creates a new ContextType with the given default value, which
+// is used when there is no matching Provider above the component in the tree.
+func CreateContext(defaultValue interface{}) *ContextType {
+	if react.Get("createContext") == js.Undefined {
+		panic("CreateContext requires React 16.3 or newer")
+	}
+	return &ContextType{Object: react.Call("createContext", wrapGo(defaultValue))}
+}
+
+// Provider creates an Element that provides the given value to the children
+// and all of their descendants.
+func (ct *ContextType) Provider(value interface{}, children ...Component) *Element {
+	e := &Element{properties: Props{}, context: ct, contextValue: value, elFactory: (*Element).createProvider}
+	for _, c := range children {
+		e.children = append(e.children, CreateIfNeeded(c))
+	}
+	return e
+}
+
+// Consumer creates an Element that renders the Component returned by the given func,
+// which receives the current context value.
+// Event listeners in the returned Component are bound to the component rendering the Consumer.
+func (ct *ContextType) Consumer(render func(value interface{}) Component) *Element {
+	return &Element{properties: Props{}, context: ct, consume: render, elFactory: (*Element).createConsumer}
+}
+
+// wrap returns the wrapped value, reusing the last wrapper if the value is the same.
+func (ct *ContextType) wrap(value interface{}) interface{} {
+	if ct.wrapped == nil || !sameValue(value, ct.value) {
+		ct.value = value
+		ct.wrapped = wrapGo(value)
+	}
+	return ct.wrapped
+}
+
+func (e *Element) createProvider() *js.Object {
+	props := e.keyProps()
+	props["value"] = e.context.wrap(e.contextValue)
+
+	args := []interface{}{e.context.Get("Provider"), props}
+	for _, c := range e.children {
+		args = append(args, c.Node())
+	}
+
+	return react.Call("createElement", args...)
+}
+
+func (e *Element) createConsumer() *js.Object {
+	f := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		defer rethrowAsJSError("Consumer")
+
+		var value interface{}
+		if len(arguments) > 0 {
+			value = unwrapGo(arguments[0])
+		}
+
+		prefix := e.consumePrefix
+		if prefix == "" {
+			prefix = "consumer"
+		}
+
+		return prepareRendered(e.ownerSetter, prefix, e.consume(value), e.owner)
+	})
+
+	return react.Call("createElement", e.context.Get("Consumer"), e.keyProps(), f)
+}
+
+// WithContextType is an option that subscribes the component to the given ContextType,
+// so its value can be read with ContextOf. A component can only have one ContextType;
+// use a Consumer for more.
+// This requires React 16.6 or newer.
+func WithContextType(ct *ContextType) Option {
+	// This needs to run before createClass
+	return Option{preparePhase: true, action: func(r *ReactComponent) error {
+		if !version.atLeast(16, 6) {
+			return errors.New("WithContextType requires React 16.6 or newer")
+		}
+		r.reactClass.setStatic("contextType", ct.Object)
+		return nil
+	}}
+}
+
+// ContextOf returns the current value of the given ContextType. The component must have
+// been created with the WithContextType option for the same ContextType.
+func (t *This) ContextOf(ct *ContextType) interface{} {
+	if t.This.Get("constructor").Get("contextType") != ct.Object {
+		panic("ContextOf: the component must be created with the WithContextType option for this ContextType")
+	}
+	return unwrapGo(t.This.Get("context"))
+}
@@ -0,0 +1,169 @@
+// Generated by evt/generate.go from "Event reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web/Events, licensed under CC-BY-SA 2.5.
+
+package gr
+
+// domEventNames maps the React property names used by the evt package to the
+// DOM event names, e.g. "onDoubleClick" to "dblclick".
+var domEventNames = map[string]string{
+	"onAbort":                    "abort",
+	"onAfterPrint":               "afterprint",
+	"onAnimationEnd":             "animationend",
+	"onAnimationIteration":       "animationiteration",
+	"onAnimationStart":           "animationstart",
+	"onAudioEnd":                 "audioend",
+	"onAudioProcess":             "audioprocess",
+	"onAudioStart":               "audiostart",
+	"onBeforePrint":              "beforeprint",
+	"onBeforeUnload":             "beforeunload",
+	"onBeginEvent":               "beginEvent",
+	"onBlocked":                  "blocked",
+	"onBlur":                     "blur",
+	"onBoundary":                 "boundary",
+	"onCached":                   "cached",
+	"onCanPlay":                  "canplay",
+	"onCanPlayThrough":           "canplaythrough",
+	"onChange":                   "change",
+	"onChargingChange":           "chargingchange",
+	"onChargingTimeChange":       "chargingtimechange",
+	"onChecking":                 "checking",
+	"onClick":                    "click",
+	"onClose":                    "close",
+	"onComplete":                 "complete",
+	"onCompositionEnd":           "compositionend",
+	"onCompositionStart":         "compositionstart",
+	"onCompositionUpdate":        "compositionupdate",
+	"onContextMenu":              "contextmenu",
+	"onCopy":                     "copy",
+	"onCut":                      "cut",
+	"onDOMContentLoaded":         "DOMContentLoaded",
+	"onDeviceLight":              "devicelight",
+	"onDeviceMotion":             "devicemotion",
+	"onDeviceOrientation":        "deviceorientation",
+	"onDeviceProximity":          "deviceproximity",
+	"onDischargingTimeChange":    "dischargingtimechange",
+	"onDoubleClick":              "dblclick",
+	"onDownloading":              "downloading",
+	"onDrag":                     "drag",
+	"onDragEnd":                  "dragend",
+	"onDragEnter":                "dragenter",
+	"onDragLeave":                "dragleave",
+	"onDragOver":                 "dragover",
+	"onDragStart":                "dragstart",
+	"onDrop":                     "drop",
+	"onDurationChange":           "durationchange",
+	"onEmptied":                  "emptied",
+	"onEnd":                      "end",
+	"onEndEvent":                 "endEvent",
+	"onEnded":                    "ended",
+	"onError":                    "error",
+	"onFocus":                    "focus",
+	"onFocusIn":                  "focusin",
+	"onFocusOut":                 "focusout",
+	"onFullScreenChange":         "fullscreenchange",
+	"onFullScreenError":          "fullscreenerror",
+	"onGamepadConnected":         "gamepadconnected",
+	"onGamepadDisconnected":      "gamepaddisconnected",
+	"onGotPointerCapture":        "gotpointercapture",
+	"onHashChange":               "hashchange",
+	"onInput":                    "input",
+	"onInvalid":                  "invalid",
+	"onKeyDown":                  "keydown",
+	"onKeyPress":                 "keypress",
+	"onKeyUp":                    "keyup",
+	"onLanguageChange":           "languagechange",
+	"onLevelChange":              "levelchange",
+	"onLoad":                     "load",
+	"onLoadEnd":                  "loadend",
+	"onLoadStart":                "loadstart",
+	"onLoadedData":               "loadeddata",
+	"onLoadedMetadata":           "loadedmetadata",
+	"onLostPointerCapture":       "lostpointercapture",
+	"onMark":                     "mark",
+	"onMessage":                  "message",
+	"onMouseDown":                "mousedown",
+	"onMouseEnter":               "mouseenter",
+	"onMouseLeave":               "mouseleave",
+	"onMouseMove":                "mousemove",
+	"onMouseOut":                 "mouseout",
+	"onMouseOver":                "mouseover",
+	"onMouseUp":                  "mouseup",
+	"onNoMatch":                  "nomatch",
+	"onNoUpdate":                 "noupdate",
+	"onNotificationClick":        "notificationclick",
+	"onObsolete":                 "obsolete",
+	"onOffline":                  "offline",
+	"onOnline":                   "online",
+	"onOpen":                     "open",
+	"onOrientationChange":        "orientationchange",
+	"onPageHide":                 "pagehide",
+	"onPageShow":                 "pageshow",
+	"onPaste":                    "paste",
+	"onPause":                    "pause",
+	"onPlay":                     "play",
+	"onPlaying":                  "playing",
+	"onPointerCancel":            "pointercancel",
+	"onPointerDown":              "pointerdown",
+	"onPointerEnter":             "pointerenter",
+	"onPointerLeave":             "pointerleave",
+	"onPointerLockChange":        "pointerlockchange",
+	"onPointerLockError":         "pointerlockerror",
+	"onPointerMove":              "pointermove",
+	"onPointerOut":               "pointerout",
+	"onPointerOver":              "pointerover",
+	"onPointerUp":                "pointerup",
+	"onPopState":                 "popstate",
+	"onProgress":                 "progress",
+	"onPush":                     "push",
+	"onPushSubscriptionChange":   "pushsubscriptionchange",
+	"onRateChange":               "ratechange",
+	"onReadyStateChange":         "readystatechange",
+	"onRepeatEvent":              "repeatEvent",
+	"onReset":                    "reset",
+	"onResize":                   "resize",
+	"onResourceTimingBufferFull": "resourcetimingbufferfull",
+	"onResult":                   "result",
+	"onResume":                   "resume",
+	"onSVGAbort":                 "SVGAbort",
+	"onSVGError":                 "SVGError",
+	"onSVGLoad":                  "SVGLoad",
+	"onSVGResize":                "SVGResize",
+	"onSVGScroll":                "SVGScroll",
+	"onSVGUnload":                "SVGUnload",
+	"onSVGZoom":                  "SVGZoom",
+	"onScroll":                   "scroll",
+	"onSeeked":                   "seeked",
+	"onSeeking":                  "seeking",
+	"onSelect":                   "select",
+	"onSelectStart":              "selectstart",
+	"onSelectionChange":          "selectionchange",
+	"onShow":                     "show",
+	"onSoundEnd":                 "soundend",
+	"onSoundStart":               "soundstart",
+	"onSpeechEnd":                "speechend",
+	"onSpeechStart":              "speechstart",
+	"onStalled":                  "stalled",
+	"onStart":                    "start",
+	"onStorage":                  "storage",
+	"onSubmit":                   "submit",
+	"onSuccess":                  "success",
+	"onSuspend":                  "suspend",
+	"onTimeUpdate":               "timeupdate",
+	"onTimeout":                  "timeout",
+	"onTouchCancel":              "touchcancel",
+	"onTouchEnd":                 "touchend",
+	"onTouchEnter":               "touchenter",
+	"onTouchLeave":               "touchleave",
+	"onTouchMove":                "touchmove",
+	"onTouchStart":               "touchstart",
+	"onTransitionEnd":            "transitionend",
+	"onUnload":                   "unload",
+	"onUpdateReady":              "updateready",
+	"onUpgradeNeeded":            "upgradeneeded",
+	"onUserProximity":            "userproximity",
+	"onVersionChange":            "versionchange",
+	"onVisibilityChange":         "visibilitychange",
+	"onVoicesChanged":            "voiceschanged",
+	"onVolumeChange":             "volumechange",
+	"onWaiting":                  "waiting",
+	"onWheel":                    "wheel",
+}
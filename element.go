@@ -17,6 +17,8 @@ limitations under the License.
 package gr
 
 import (
+	"fmt"
+
 	"github.com/gopherjs/gopherjs/js"
 )
 
@@ -53,6 +55,30 @@ type Element struct {
 	// This can be switched with the Dynamic modifier.
 	dynamic bool
 
+	// Whether this is a React.Fragment.
+	fragment bool
+
+	// Whether this element got its key from Map. The automatic keys in
+	// its subtree are then scoped to the element.
+	keyed bool
+
+	// The ID of the DOM container if this is a portal.
+	portalContainerID string
+
+	// The context if this is a context Provider or Consumer.
+	context      *ContextType
+	contextValue interface{}
+
+	// The render func if this is a context Consumer, with the component it
+	// is rendered in.
+	consume     func(value interface{}) Component
+	owner       *This
+	ownerSetter ThisSetter
+
+	// The prefix used for the missing keys in the Component rendered by the
+	// Consumer, unique within the owner.
+	consumePrefix string
+
 	// This is the actual ReactJS element.
 	// ReactElement, ReactText or a ReactFragment
 	element *js.Object
@@ -63,6 +89,44 @@ func NewElement(tag string) *Element {
 	return &Element{tag: tag, properties: Props{}, elFactory: defaultElementFactory}
 }
 
+// Fragment creates an Element that groups the children given by the modifiers
+// without adding an extra node to the DOM.
+// Note that a Fragment can only have a key and children, any other attributes,
+// styles etc. are ignored. Fragments require React 16.2 or newer.
+//
+// See https://reactjs.org/docs/fragments.html
+func Fragment(mods ...Modifier) *Element {
+	e := &Element{properties: Props{}, fragment: true, elFactory: (*Element).createFragment}
+	Modifiers(mods).Modify(e)
+	return e
+}
+
+// KeyedFragment creates a Fragment with the given key, typically used when
+// rendering a list of fragments.
+func KeyedFragment(key string, mods ...Modifier) *Element {
+	e := Fragment(mods...)
+	e.properties["key"] = key
+	return e
+}
+
+// Portal creates an Element that renders the child into the DOM element with the
+// given ID, outside of the DOM hierarchy of the parent component.
+// This is useful for modals, tooltips and similar.
+//
+// Event listeners added to the child are bound to the component rendering the portal,
+// and events bubble up through the React tree as usual.
+// Portals require React 16 or newer.
+//
+// See https://reactjs.org/docs/portals.html
+func Portal(containerID string, child Component) *Element {
+	return &Element{
+		properties:        Props{},
+		children:          []Component{CreateIfNeeded(child)},
+		portalContainerID: containerID,
+		elFactory:         (*Element).createPortal,
+	}
+}
+
 // NewPreparedElement creates an Element from a ready-to-use React element.
 func NewPreparedElement(o *js.Object) *Element {
 	return &Element{element: o, elFactory: returnStoredElement}
@@ -115,6 +179,158 @@ func (e *Element) createElement() *js.Object {
 
 }
 
+func (e *Element) createFragment() *js.Object {
+	fragment := react.Get("Fragment")
+	if fragment == js.Undefined {
+		panic("Fragments require React 16.2 or newer")
+	}
+
+	var args []interface{}
+
+	for _, c := range e.children {
+		args = append(args, c.Node())
+	}
+
+	return react.Call("createElement", append([]interface{}{fragment, e.keyProps()}, args...)...)
+}
+
+// keyProps returns the props holding only the key, if set.
+func (e *Element) keyProps() map[string]interface{} {
+	props := make(map[string]interface{})
+	if key, ok := e.properties["key"]; ok {
+		props["key"] = key
+	}
+	return props
+}
+
+// propComponent returns v as a Component if it can be passed in props, e.g. an *Element.
+func propComponent(v interface{}) (Component, bool) {
+	switch c := v.(type) {
+	case *Element:
+		return c, c != nil
+	case Factory:
+		return nil, false
+	case Component:
+		return c, true
+	}
+	return nil, false
+}
+
+// componentNode returns the React node for v if it is a Component that can be
+// passed in props, see propComponent.
+func componentNode(v interface{}) (*js.Object, bool) {
+	if c, ok := propComponent(v); ok {
+		return c.Node(), true
+	}
+	return nil, false
+}
+
+// nodeProps returns the props with any Component values, also in nested maps and
+// slices, converted to React nodes, so they can be rendered by the receiver and
+// validated by PropTypes.
+func nodeProps(props map[string]interface{}) map[string]interface{} {
+	if v, changed := nodeValue(props); changed {
+		return v.(map[string]interface{})
+	}
+	return props
+}
+
+// nodeValue converts v as described in nodeProps. It reports whether
+// anything was converted; v is not modified.
+func nodeValue(v interface{}) (interface{}, bool) {
+	if node, ok := componentNode(v); ok {
+		return node, true
+	}
+
+	switch vv := v.(type) {
+	case Props:
+		return nodeValue(map[string]interface{}(vv))
+	case map[string]interface{}:
+		var converted map[string]interface{}
+		for k, v2 := range vv {
+			n, changed := nodeValue(v2)
+			if !changed {
+				continue
+			}
+			if converted == nil {
+				converted = make(map[string]interface{}, len(vv))
+				for k2, v3 := range vv {
+					converted[k2] = v3
+				}
+			}
+			converted[k] = n
+		}
+		if converted != nil {
+			return converted, true
+		}
+	case []interface{}:
+		var converted []interface{}
+		for i, v2 := range vv {
+			n, changed := nodeValue(v2)
+			if !changed {
+				continue
+			}
+			if converted == nil {
+				converted = make([]interface{}, len(vv))
+				copy(converted, vv)
+			}
+			converted[i] = n
+		}
+		if converted != nil {
+			return converted, true
+		}
+	}
+
+	return v, false
+}
+
+// propElements invokes f for every Element in the given props, also in nested
+// maps and slices. These need event listeners and keys as the children do.
+func propElements(v interface{}, f func(e *Element)) {
+	switch vv := v.(type) {
+	case *Element:
+		if vv != nil {
+			f(vv)
+		}
+	case Props:
+		propElements(map[string]interface{}(vv), f)
+	case map[string]interface{}:
+		for _, v2 := range vv {
+			propElements(v2, f)
+		}
+	case []interface{}:
+		for _, v2 := range vv {
+			propElements(v2, f)
+		}
+	}
+}
+
+func (e *Element) createPortal() *js.Object {
+	if reactDOM.Get("createPortal") == js.Undefined {
+		panic("Portals require React 16 or newer")
+	}
+
+	container := js.Global.Get("document").Call("getElementById", e.portalContainerID)
+	if isNullOrUndefined(container) {
+		panic(fmt.Sprintf("Portal container %q not found", e.portalContainerID))
+	}
+
+	child := e.children[0].Node()
+
+	if key, ok := e.properties["key"]; ok {
+		return reactDOM.Call("createPortal", child, container, key)
+	}
+
+	return reactDOM.Call("createPortal", child, container)
+}
+
+// isVirtual reports whether this element is a Fragment, a Portal or a context
+// Provider or Consumer, i.e. it will not end up as a node in the DOM at its
+// position in the tree.
+func (e *Element) isVirtual() bool {
+	return e.fragment || e.portalContainerID != "" || e.context != nil
+}
+
 func createElement(tag string, props map[string]interface{}, args []interface{}) *js.Object {
 	if len(args) == 0 {
 		return react.Call("createElement", tag, props)
@@ -17,12 +17,17 @@ limitations under the License.
 package gr
 
 import (
+	"time"
+
 	"github.com/gopherjs/gopherjs/js"
 )
 
 // Event represents a browser event. See https://developer.mozilla.org/en-US/docs/Web/Events
 type Event struct {
 	*js.Object
+
+	// This is the this context of the component the listener was added in.
+	// It is nil for listeners added in function components, see NewFunc.
 	This *This
 }
 
@@ -58,7 +63,16 @@ type EventListener struct {
 	listener        func(*Event)
 	preventDefault  bool
 	stopPropagation bool
+	capture         bool
+	once            bool
 	delegate        func(jsEvent *js.Object)
+
+	// Debounce and throttle settings.
+	wait     time.Duration
+	throttle bool
+
+	// Used when the event has no currentTarget to store the state on.
+	state listenerState
 }
 
 // PreventDefault prevents the default event behaviour in the browser.
@@ -75,6 +89,153 @@ func (l *EventListener) StopPropagation() *EventListener {
 	return l
 }
 
+// Capture makes the listener get notified in the capturing phase, i.e. before any
+// listeners of the same event on the children.
+//
+// See https://reactjs.org/docs/events.html#supported-events
+func (l *EventListener) Capture() *EventListener {
+	l.capture = true
+	return l
+}
+
+// Once makes the listener get notified only the first time the event fires.
+// This is tracked on the DOM node the listener is attached to, so it survives
+// re-renders, also in function components, for as long as React keeps the node.
+func (l *EventListener) Once() *EventListener {
+	l.once = true
+	return l
+}
+
+// Debounce delays notifying the listener until d has passed without the event firing,
+// and then notifies it with the last event. This is useful for e.g. mouse moves and input
+// that trigger expensive work.
+//
+// The event is kept alive for the delayed listener, see Event.Persist. Pending
+// notifications are dropped when the component is unmounted or, in function components,
+// when the DOM node is removed from the document.
+// As for Once, the timing is tracked on the DOM node.
+func (l *EventListener) Debounce(d time.Duration) *EventListener {
+	l.wait = d
+	l.throttle = false
+	return l
+}
+
+// Throttle makes the listener get notified at most once every d. The first event is
+// passed on immediately, and the last event within the period is passed on when it ends.
+//
+// See Debounce for more information.
+func (l *EventListener) Throttle(d time.Duration) *EventListener {
+	l.wait = d
+	l.throttle = true
+	return l
+}
+
+// schedule runs the given notify func now or later, see Debounce and Throttle.
+func (l *EventListener) schedule(s *listenerState, event *js.Object, that *This, notify func()) {
+	if l.wait <= 0 {
+		notify()
+		return
+	}
+
+	if l.throttle && s.timer == nil && time.Since(s.last) >= l.wait {
+		s.last = time.Now()
+		notify()
+		return
+	}
+
+	// The currentTarget is reset when the event has been dispatched.
+	var node *js.Object
+	if !isNullOrUndefined(event) {
+		node = event.Get("currentTarget")
+
+		// React reuses the event objects before React 17.
+		if event.Get("persist") != js.Undefined {
+			event.Call("persist")
+		}
+	}
+
+	// The latest notify func is used, with the listener from the latest render.
+	s.pending = notify
+
+	wait := l.wait
+
+	if l.throttle {
+		if s.timer != nil {
+			return
+		}
+		wait -= time.Since(s.last)
+	} else if s.timer != nil {
+		js.Global.Call("clearTimeout", s.timer)
+	}
+
+	s.timer = js.Global.Call("setTimeout", func() {
+		s.timer = nil
+		s.last = time.Now()
+
+		if that != nil && isUnmounted(that.This) {
+			return
+		}
+
+		// There is no this context in function components, but React removes
+		// the DOM nodes of unmounted components from the document.
+		if !isNullOrUndefined(node) {
+			if connected := node.Get("isConnected"); connected != js.Undefined && !connected.Bool() {
+				return
+			}
+		}
+
+		s.pending()
+	}, wait.Seconds()*1000)
+}
+
+// listenerStateKey is the property set on the DOM node, see listenerStateFor.
+const listenerStateKey = "__grListenerState"
+
+// listenerState is the state of a listener that must survive re-renders.
+type listenerState struct {
+	fired bool
+
+	// Debounce and throttle state.
+	timer   *js.Object
+	pending func()
+	last    time.Time
+}
+
+// listenerStateFor returns the state of the listener with the given id stored on
+// the DOM node the event is dispatched to, i.e. its currentTarget. The fallback is
+// returned if there is no such node, e.g. when called from tests.
+func listenerStateFor(event *js.Object, id string, fallback *listenerState) *listenerState {
+	if isNullOrUndefined(event) {
+		return fallback
+	}
+	node := event.Get("currentTarget")
+	if isNullOrUndefined(node) {
+		return fallback
+	}
+
+	states := node.Get(listenerStateKey)
+	if isNullOrUndefined(states) {
+		states = js.Global.Get("Object").New()
+		node.Set(listenerStateKey, states)
+	}
+
+	if s := states.Get(id); !isNullOrUndefined(s) {
+		return unwrapGo(s).(*listenerState)
+	}
+
+	s := &listenerState{}
+	states.Set(id, wrapGo(s))
+	return s
+}
+
+// propName returns the name of the React property to set, e.g. onClickCapture.
+func (l *EventListener) propName() string {
+	if l.capture {
+		return l.name + "Capture"
+	}
+	return l.name
+}
+
 // Listener is the signature for the func that needs to be implemented by the
 // listener, e.g. the clickHandler etc.
 type Listener func(*Event)
@@ -14,6 +14,11 @@ func Abort(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onAbort", listener)
 }
 
+// AbortCapture is the same as Abort, but gets notified in the capturing phase.
+func AbortCapture(listener gr.Listener) *gr.EventListener {
+	return Abort(listener).Capture()
+}
+
 // AfterPrint gets notified when the associated document has started printing or the print preview has been closed.
 //
 // https://developer.mozilla.org/docs/Web/Events/afterprint
@@ -21,6 +26,11 @@ func AfterPrint(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onAfterPrint", listener)
 }
 
+// AfterPrintCapture is the same as AfterPrint, but gets notified in the capturing phase.
+func AfterPrintCapture(listener gr.Listener) *gr.EventListener {
+	return AfterPrint(listener).Capture()
+}
+
 // AnimationEnd gets notified when a CSS animation has completed.
 //
 // https://developer.mozilla.org/docs/Web/Events/animationend
@@ -28,6 +38,11 @@ func AnimationEnd(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onAnimationEnd", listener)
 }
 
+// AnimationEndCapture is the same as AnimationEnd, but gets notified in the capturing phase.
+func AnimationEndCapture(listener gr.Listener) *gr.EventListener {
+	return AnimationEnd(listener).Capture()
+}
+
 // AnimationIteration gets notified when a CSS animation is repeated.
 //
 // https://developer.mozilla.org/docs/Web/Events/animationiteration
@@ -35,6 +50,11 @@ func AnimationIteration(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onAnimationIteration", listener)
 }
 
+// AnimationIterationCapture is the same as AnimationIteration, but gets notified in the capturing phase.
+func AnimationIterationCapture(listener gr.Listener) *gr.EventListener {
+	return AnimationIteration(listener).Capture()
+}
+
 // AnimationStart gets notified when a CSS animation has started.
 //
 // https://developer.mozilla.org/docs/Web/Events/animationstart
@@ -42,6 +62,11 @@ func AnimationStart(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onAnimationStart", listener)
 }
 
+// AnimationStartCapture is the same as AnimationStart, but gets notified in the capturing phase.
+func AnimationStartCapture(listener gr.Listener) *gr.EventListener {
+	return AnimationStart(listener).Capture()
+}
+
 // AudioEnd gets notified when the user agent has finished capturing audio for speech recognition.
 //
 // https://developer.mozilla.org/docs/Web/Events/audioend
@@ -49,6 +74,11 @@ func AudioEnd(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onAudioEnd", listener)
 }
 
+// AudioEndCapture is the same as AudioEnd, but gets notified in the capturing phase.
+func AudioEndCapture(listener gr.Listener) *gr.EventListener {
+	return AudioEnd(listener).Capture()
+}
+
 // AudioProcess gets notified when the input buffer of a ScriptProcessorNode is ready to be processed.
 //
 // https://developer.mozilla.org/docs/Web/Events/audioprocess
@@ -56,6 +86,11 @@ func AudioProcess(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onAudioProcess", listener)
 }
 
+// AudioProcessCapture is the same as AudioProcess, but gets notified in the capturing phase.
+func AudioProcessCapture(listener gr.Listener) *gr.EventListener {
+	return AudioProcess(listener).Capture()
+}
+
 // AudioStart gets notified when the user agent has started to capture audio for speech recognition.
 //
 // https://developer.mozilla.org/docs/Web/Events/audiostart
@@ -63,6 +98,11 @@ func AudioStart(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onAudioStart", listener)
 }
 
+// AudioStartCapture is the same as AudioStart, but gets notified in the capturing phase.
+func AudioStartCapture(listener gr.Listener) *gr.EventListener {
+	return AudioStart(listener).Capture()
+}
+
 // BeforePrint gets notified when the associated document is about to be printed or previewed for printing.
 //
 // https://developer.mozilla.org/docs/Web/Events/beforeprint
@@ -70,6 +110,11 @@ func BeforePrint(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onBeforePrint", listener)
 }
 
+// BeforePrintCapture is the same as BeforePrint, but gets notified in the capturing phase.
+func BeforePrintCapture(listener gr.Listener) *gr.EventListener {
+	return BeforePrint(listener).Capture()
+}
+
 // BeforeUnload gets notified when (no documentation)
 //
 // https://developer.mozilla.org/docs/Web/Events/beforeunload
@@ -77,6 +122,11 @@ func BeforeUnload(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onBeforeUnload", listener)
 }
 
+// BeforeUnloadCapture is the same as BeforeUnload, but gets notified in the capturing phase.
+func BeforeUnloadCapture(listener gr.Listener) *gr.EventListener {
+	return BeforeUnload(listener).Capture()
+}
+
 // BeginEvent gets notified when a SMIL animation element begins.
 //
 // https://developer.mozilla.org/docs/Web/Events/beginEvent
@@ -84,6 +134,11 @@ func BeginEvent(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onBeginEvent", listener)
 }
 
+// BeginEventCapture is the same as BeginEvent, but gets notified in the capturing phase.
+func BeginEventCapture(listener gr.Listener) *gr.EventListener {
+	return BeginEvent(listener).Capture()
+}
+
 // Blocked gets notified when an open connection to a database is blocking a versionchange transaction on the same database.
 //
 // https://developer.mozilla.org/docs/Web/Reference/Events/blocked_indexedDB
@@ -91,6 +146,11 @@ func Blocked(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onBlocked", listener)
 }
 
+// BlockedCapture is the same as Blocked, but gets notified in the capturing phase.
+func BlockedCapture(listener gr.Listener) *gr.EventListener {
+	return Blocked(listener).Capture()
+}
+
 // Blur gets notified when an element has lost focus (does not bubble).
 //
 // https://developer.mozilla.org/docs/Web/Events/blur
@@ -98,6 +158,16 @@ func Blur(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onBlur", listener)
 }
 
+// BlurCapture is the same as Blur, but gets notified in the capturing phase.
+func BlurCapture(listener gr.Listener) *gr.EventListener {
+	return Blur(listener).Capture()
+}
+
+// BlurF is the same as Blur, but with a typed FocusEvent.
+func BlurF(listener func(*gr.FocusEvent)) *gr.EventListener {
+	return gr.NewEventListener("onBlur", gr.FocusListener(listener))
+}
+
 // Boundary gets notified when the spoken utterance reaches a word or sentence boundary
 //
 // https://developer.mozilla.org/docs/Web/Events/boundary
@@ -105,6 +175,11 @@ func Boundary(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onBoundary", listener)
 }
 
+// BoundaryCapture is the same as Boundary, but gets notified in the capturing phase.
+func BoundaryCapture(listener gr.Listener) *gr.EventListener {
+	return Boundary(listener).Capture()
+}
+
 // Cached gets notified when the resources listed in the manifest have been downloaded, and the application is now cached.
 //
 // https://developer.mozilla.org/docs/Web/Events/cached
@@ -112,6 +187,11 @@ func Cached(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onCached", listener)
 }
 
+// CachedCapture is the same as Cached, but gets notified in the capturing phase.
+func CachedCapture(listener gr.Listener) *gr.EventListener {
+	return Cached(listener).Capture()
+}
+
 // CanPlay gets notified when the user agent can play the media, but estimates that not enough data has been loaded to play the media up to its end without having to stop for further buffering of content.
 //
 // https://developer.mozilla.org/docs/Web/Events/canplay
@@ -119,6 +199,11 @@ func CanPlay(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onCanPlay", listener)
 }
 
+// CanPlayCapture is the same as CanPlay, but gets notified in the capturing phase.
+func CanPlayCapture(listener gr.Listener) *gr.EventListener {
+	return CanPlay(listener).Capture()
+}
+
 // CanPlayThrough gets notified when the user agent can play the media, and estimates that enough data has been loaded to play the media up to its end without having to stop for further buffering of content.
 //
 // https://developer.mozilla.org/docs/Web/Events/canplaythrough
@@ -126,6 +211,11 @@ func CanPlayThrough(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onCanPlayThrough", listener)
 }
 
+// CanPlayThroughCapture is the same as CanPlayThrough, but gets notified in the capturing phase.
+func CanPlayThroughCapture(listener gr.Listener) *gr.EventListener {
+	return CanPlayThrough(listener).Capture()
+}
+
 // Change gets notified when the change event is fired for <input>, <select>, and <textarea> elements when a change to the element's value is committed by the user.
 //
 // https://developer.mozilla.org/docs/Web/Events/change
@@ -133,6 +223,11 @@ func Change(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onChange", listener)
 }
 
+// ChangeCapture is the same as Change, but gets notified in the capturing phase.
+func ChangeCapture(listener gr.Listener) *gr.EventListener {
+	return Change(listener).Capture()
+}
+
 // ChargingChange gets notified when the battery begins or stops charging.
 //
 // https://developer.mozilla.org/docs/Web/Events/chargingchange
@@ -140,6 +235,11 @@ func ChargingChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onChargingChange", listener)
 }
 
+// ChargingChangeCapture is the same as ChargingChange, but gets notified in the capturing phase.
+func ChargingChangeCapture(listener gr.Listener) *gr.EventListener {
+	return ChargingChange(listener).Capture()
+}
+
 // ChargingTimeChange gets notified when the chargingTime attribute has been updated.
 //
 // https://developer.mozilla.org/docs/Web/Events/chargingtimechange
@@ -147,6 +247,11 @@ func ChargingTimeChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onChargingTimeChange", listener)
 }
 
+// ChargingTimeChangeCapture is the same as ChargingTimeChange, but gets notified in the capturing phase.
+func ChargingTimeChangeCapture(listener gr.Listener) *gr.EventListener {
+	return ChargingTimeChange(listener).Capture()
+}
+
 // Checking gets notified when the user agent is checking for an update, or attempting to download the cache manifest for the first time.
 //
 // https://developer.mozilla.org/docs/Web/Events/checking
@@ -154,6 +259,11 @@ func Checking(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onChecking", listener)
 }
 
+// CheckingCapture is the same as Checking, but gets notified in the capturing phase.
+func CheckingCapture(listener gr.Listener) *gr.EventListener {
+	return Checking(listener).Capture()
+}
+
 // Click gets notified when a pointing device button has been pressed and released on an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/click
@@ -161,6 +271,16 @@ func Click(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onClick", listener)
 }
 
+// ClickCapture is the same as Click, but gets notified in the capturing phase.
+func ClickCapture(listener gr.Listener) *gr.EventListener {
+	return Click(listener).Capture()
+}
+
+// ClickM is the same as Click, but with a typed MouseEvent.
+func ClickM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onClick", gr.MouseListener(listener))
+}
+
 // Close gets notified when a WebSocket connection has been closed.
 //
 // https://developer.mozilla.org/docs/Web/Reference/Events/close_websocket
@@ -168,6 +288,11 @@ func Close(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onClose", listener)
 }
 
+// CloseCapture is the same as Close, but gets notified in the capturing phase.
+func CloseCapture(listener gr.Listener) *gr.EventListener {
+	return Close(listener).Capture()
+}
+
 // Complete gets notified when the rendering of an OfflineAudioContext is terminated.
 //
 // https://developer.mozilla.org/docs/Web/Events/complete
@@ -175,6 +300,11 @@ func Complete(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onComplete", listener)
 }
 
+// CompleteCapture is the same as Complete, but gets notified in the capturing phase.
+func CompleteCapture(listener gr.Listener) *gr.EventListener {
+	return Complete(listener).Capture()
+}
+
 // CompositionEnd gets notified when the composition of a passage of text has been completed or canceled.
 //
 // https://developer.mozilla.org/docs/Web/Events/compositionend
@@ -182,6 +312,11 @@ func CompositionEnd(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onCompositionEnd", listener)
 }
 
+// CompositionEndCapture is the same as CompositionEnd, but gets notified in the capturing phase.
+func CompositionEndCapture(listener gr.Listener) *gr.EventListener {
+	return CompositionEnd(listener).Capture()
+}
+
 // CompositionStart gets notified when the composition of a passage of text is prepared (similar to keydown for a keyboard input, but works with other inputs such as speech recognition).
 //
 // https://developer.mozilla.org/docs/Web/Events/compositionstart
@@ -189,6 +324,11 @@ func CompositionStart(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onCompositionStart", listener)
 }
 
+// CompositionStartCapture is the same as CompositionStart, but gets notified in the capturing phase.
+func CompositionStartCapture(listener gr.Listener) *gr.EventListener {
+	return CompositionStart(listener).Capture()
+}
+
 // CompositionUpdate gets notified when a character is added to a passage of text being composed.
 //
 // https://developer.mozilla.org/docs/Web/Events/compositionupdate
@@ -196,6 +336,11 @@ func CompositionUpdate(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onCompositionUpdate", listener)
 }
 
+// CompositionUpdateCapture is the same as CompositionUpdate, but gets notified in the capturing phase.
+func CompositionUpdateCapture(listener gr.Listener) *gr.EventListener {
+	return CompositionUpdate(listener).Capture()
+}
+
 // ContextMenu gets notified when the right button of the mouse is clicked (before the context menu is displayed).
 //
 // https://developer.mozilla.org/docs/Web/Events/contextmenu
@@ -203,6 +348,16 @@ func ContextMenu(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onContextMenu", listener)
 }
 
+// ContextMenuCapture is the same as ContextMenu, but gets notified in the capturing phase.
+func ContextMenuCapture(listener gr.Listener) *gr.EventListener {
+	return ContextMenu(listener).Capture()
+}
+
+// ContextMenuM is the same as ContextMenu, but with a typed MouseEvent.
+func ContextMenuM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onContextMenu", gr.MouseListener(listener))
+}
+
 // Copy gets notified when the text selection has been added to the clipboard.
 //
 // https://developer.mozilla.org/docs/Web/Events/copy
@@ -210,6 +365,16 @@ func Copy(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onCopy", listener)
 }
 
+// CopyCapture is the same as Copy, but gets notified in the capturing phase.
+func CopyCapture(listener gr.Listener) *gr.EventListener {
+	return Copy(listener).Capture()
+}
+
+// CopyC is the same as Copy, but with a typed ClipboardEvent.
+func CopyC(listener func(*gr.ClipboardEvent)) *gr.EventListener {
+	return gr.NewEventListener("onCopy", gr.ClipboardListener(listener))
+}
+
 // Cut gets notified when the text selection has been removed from the document and added to the clipboard.
 //
 // https://developer.mozilla.org/docs/Web/Events/cut
@@ -217,6 +382,16 @@ func Cut(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onCut", listener)
 }
 
+// CutCapture is the same as Cut, but gets notified in the capturing phase.
+func CutCapture(listener gr.Listener) *gr.EventListener {
+	return Cut(listener).Capture()
+}
+
+// CutC is the same as Cut, but with a typed ClipboardEvent.
+func CutC(listener func(*gr.ClipboardEvent)) *gr.EventListener {
+	return gr.NewEventListener("onCut", gr.ClipboardListener(listener))
+}
+
 // DOMContentLoaded gets notified when the document has finished loading (but not its dependent resources).
 //
 // https://developer.mozilla.org/docs/Web/Events/DOMContentLoaded
@@ -224,6 +399,11 @@ func DOMContentLoaded(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDOMContentLoaded", listener)
 }
 
+// DOMContentLoadedCapture is the same as DOMContentLoaded, but gets notified in the capturing phase.
+func DOMContentLoadedCapture(listener gr.Listener) *gr.EventListener {
+	return DOMContentLoaded(listener).Capture()
+}
+
 // DeviceLight gets notified when fresh data is available from a light sensor.
 //
 // https://developer.mozilla.org/docs/Web/Events/devicelight
@@ -231,6 +411,11 @@ func DeviceLight(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDeviceLight", listener)
 }
 
+// DeviceLightCapture is the same as DeviceLight, but gets notified in the capturing phase.
+func DeviceLightCapture(listener gr.Listener) *gr.EventListener {
+	return DeviceLight(listener).Capture()
+}
+
 // DeviceMotion gets notified when fresh data is available from a motion sensor.
 //
 // https://developer.mozilla.org/docs/Web/Events/devicemotion
@@ -238,6 +423,11 @@ func DeviceMotion(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDeviceMotion", listener)
 }
 
+// DeviceMotionCapture is the same as DeviceMotion, but gets notified in the capturing phase.
+func DeviceMotionCapture(listener gr.Listener) *gr.EventListener {
+	return DeviceMotion(listener).Capture()
+}
+
 // DeviceOrientation gets notified when fresh data is available from an orientation sensor.
 //
 // https://developer.mozilla.org/docs/Web/Events/deviceorientation
@@ -245,6 +435,11 @@ func DeviceOrientation(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDeviceOrientation", listener)
 }
 
+// DeviceOrientationCapture is the same as DeviceOrientation, but gets notified in the capturing phase.
+func DeviceOrientationCapture(listener gr.Listener) *gr.EventListener {
+	return DeviceOrientation(listener).Capture()
+}
+
 // DeviceProximity gets notified when fresh data is available from a proximity sensor (indicates an approximated distance between the device and a nearby object).
 //
 // https://developer.mozilla.org/docs/Web/Events/deviceproximity
@@ -252,6 +447,11 @@ func DeviceProximity(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDeviceProximity", listener)
 }
 
+// DeviceProximityCapture is the same as DeviceProximity, but gets notified in the capturing phase.
+func DeviceProximityCapture(listener gr.Listener) *gr.EventListener {
+	return DeviceProximity(listener).Capture()
+}
+
 // DischargingTimeChange gets notified when the dischargingTime attribute has been updated.
 //
 // https://developer.mozilla.org/docs/Web/Events/dischargingtimechange
@@ -259,6 +459,11 @@ func DischargingTimeChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDischargingTimeChange", listener)
 }
 
+// DischargingTimeChangeCapture is the same as DischargingTimeChange, but gets notified in the capturing phase.
+func DischargingTimeChangeCapture(listener gr.Listener) *gr.EventListener {
+	return DischargingTimeChange(listener).Capture()
+}
+
 // DoubleClick gets notified when a pointing device button is clicked twice on an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/dblclick
@@ -266,6 +471,16 @@ func DoubleClick(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDoubleClick", listener)
 }
 
+// DoubleClickCapture is the same as DoubleClick, but gets notified in the capturing phase.
+func DoubleClickCapture(listener gr.Listener) *gr.EventListener {
+	return DoubleClick(listener).Capture()
+}
+
+// DoubleClickM is the same as DoubleClick, but with a typed MouseEvent.
+func DoubleClickM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onDoubleClick", gr.MouseListener(listener))
+}
+
 // Downloading gets notified when the user agent has found an update and is fetching it, or is downloading the resources listed by the cache manifest for the first time.
 //
 // https://developer.mozilla.org/docs/Web/Events/downloading
@@ -273,6 +488,11 @@ func Downloading(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDownloading", listener)
 }
 
+// DownloadingCapture is the same as Downloading, but gets notified in the capturing phase.
+func DownloadingCapture(listener gr.Listener) *gr.EventListener {
+	return Downloading(listener).Capture()
+}
+
 // Drag gets notified when an element or text selection is being dragged (every 350ms).
 //
 // https://developer.mozilla.org/docs/Web/Events/drag
@@ -280,6 +500,16 @@ func Drag(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDrag", listener)
 }
 
+// DragCapture is the same as Drag, but gets notified in the capturing phase.
+func DragCapture(listener gr.Listener) *gr.EventListener {
+	return Drag(listener).Capture()
+}
+
+// DragD is the same as Drag, but with a typed DragEvent.
+func DragD(listener func(*gr.DragEvent)) *gr.EventListener {
+	return gr.NewEventListener("onDrag", gr.DragListener(listener))
+}
+
 // DragEnd gets notified when a drag operation is being ended (by releasing a mouse button or hitting the escape key).
 //
 // https://developer.mozilla.org/docs/Web/Events/dragend
@@ -287,6 +517,16 @@ func DragEnd(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDragEnd", listener)
 }
 
+// DragEndCapture is the same as DragEnd, but gets notified in the capturing phase.
+func DragEndCapture(listener gr.Listener) *gr.EventListener {
+	return DragEnd(listener).Capture()
+}
+
+// DragEndD is the same as DragEnd, but with a typed DragEvent.
+func DragEndD(listener func(*gr.DragEvent)) *gr.EventListener {
+	return gr.NewEventListener("onDragEnd", gr.DragListener(listener))
+}
+
 // DragEnter gets notified when a dragged element or text selection enters a valid drop target.
 //
 // https://developer.mozilla.org/docs/Web/Events/dragenter
@@ -294,6 +534,16 @@ func DragEnter(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDragEnter", listener)
 }
 
+// DragEnterCapture is the same as DragEnter, but gets notified in the capturing phase.
+func DragEnterCapture(listener gr.Listener) *gr.EventListener {
+	return DragEnter(listener).Capture()
+}
+
+// DragEnterD is the same as DragEnter, but with a typed DragEvent.
+func DragEnterD(listener func(*gr.DragEvent)) *gr.EventListener {
+	return gr.NewEventListener("onDragEnter", gr.DragListener(listener))
+}
+
 // DragLeave gets notified when a dragged element or text selection leaves a valid drop target.
 //
 // https://developer.mozilla.org/docs/Web/Events/dragleave
@@ -301,6 +551,16 @@ func DragLeave(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDragLeave", listener)
 }
 
+// DragLeaveCapture is the same as DragLeave, but gets notified in the capturing phase.
+func DragLeaveCapture(listener gr.Listener) *gr.EventListener {
+	return DragLeave(listener).Capture()
+}
+
+// DragLeaveD is the same as DragLeave, but with a typed DragEvent.
+func DragLeaveD(listener func(*gr.DragEvent)) *gr.EventListener {
+	return gr.NewEventListener("onDragLeave", gr.DragListener(listener))
+}
+
 // DragOver gets notified when an element or text selection is being dragged over a valid drop target (every 350ms).
 //
 // https://developer.mozilla.org/docs/Web/Events/dragover
@@ -308,6 +568,16 @@ func DragOver(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDragOver", listener)
 }
 
+// DragOverCapture is the same as DragOver, but gets notified in the capturing phase.
+func DragOverCapture(listener gr.Listener) *gr.EventListener {
+	return DragOver(listener).Capture()
+}
+
+// DragOverD is the same as DragOver, but with a typed DragEvent.
+func DragOverD(listener func(*gr.DragEvent)) *gr.EventListener {
+	return gr.NewEventListener("onDragOver", gr.DragListener(listener))
+}
+
 // DragStart gets notified when the user starts dragging an element or text selection.
 //
 // https://developer.mozilla.org/docs/Web/Events/dragstart
@@ -315,6 +585,16 @@ func DragStart(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDragStart", listener)
 }
 
+// DragStartCapture is the same as DragStart, but gets notified in the capturing phase.
+func DragStartCapture(listener gr.Listener) *gr.EventListener {
+	return DragStart(listener).Capture()
+}
+
+// DragStartD is the same as DragStart, but with a typed DragEvent.
+func DragStartD(listener func(*gr.DragEvent)) *gr.EventListener {
+	return gr.NewEventListener("onDragStart", gr.DragListener(listener))
+}
+
 // Drop gets notified when an element is dropped on a valid drop target.
 //
 // https://developer.mozilla.org/docs/Web/Events/drop
@@ -322,6 +602,16 @@ func Drop(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDrop", listener)
 }
 
+// DropCapture is the same as Drop, but gets notified in the capturing phase.
+func DropCapture(listener gr.Listener) *gr.EventListener {
+	return Drop(listener).Capture()
+}
+
+// DropD is the same as Drop, but with a typed DragEvent.
+func DropD(listener func(*gr.DragEvent)) *gr.EventListener {
+	return gr.NewEventListener("onDrop", gr.DragListener(listener))
+}
+
 // DurationChange gets notified when the duration attribute has been updated.
 //
 // https://developer.mozilla.org/docs/Web/Events/durationchange
@@ -329,6 +619,11 @@ func DurationChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onDurationChange", listener)
 }
 
+// DurationChangeCapture is the same as DurationChange, but gets notified in the capturing phase.
+func DurationChangeCapture(listener gr.Listener) *gr.EventListener {
+	return DurationChange(listener).Capture()
+}
+
 // Emptied gets notified when the media has become empty; for example, this event is sent if the media has already been loaded (or partially loaded), and the load() method is called to reload it.
 //
 // https://developer.mozilla.org/docs/Web/Events/emptied
@@ -336,6 +631,11 @@ func Emptied(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onEmptied", listener)
 }
 
+// EmptiedCapture is the same as Emptied, but gets notified in the capturing phase.
+func EmptiedCapture(listener gr.Listener) *gr.EventListener {
+	return Emptied(listener).Capture()
+}
+
 // End gets notified when the utterance has finished being spoken.
 //
 // https://developer.mozilla.org/docs/Web/Events/end_(SpeechSynthesis)
@@ -343,6 +643,11 @@ func End(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onEnd", listener)
 }
 
+// EndCapture is the same as End, but gets notified in the capturing phase.
+func EndCapture(listener gr.Listener) *gr.EventListener {
+	return End(listener).Capture()
+}
+
 // EndEvent gets notified when a SMIL animation element ends.
 //
 // https://developer.mozilla.org/docs/Web/Events/endEvent
@@ -350,6 +655,11 @@ func EndEvent(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onEndEvent", listener)
 }
 
+// EndEventCapture is the same as EndEvent, but gets notified in the capturing phase.
+func EndEventCapture(listener gr.Listener) *gr.EventListener {
+	return EndEvent(listener).Capture()
+}
+
 // Ended gets notified when (no documentation)
 //
 // https://developer.mozilla.org/docs/Web/Events/ended_(Web_Audio)
@@ -357,6 +667,11 @@ func Ended(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onEnded", listener)
 }
 
+// EndedCapture is the same as Ended, but gets notified in the capturing phase.
+func EndedCapture(listener gr.Listener) *gr.EventListener {
+	return Ended(listener).Capture()
+}
+
 // Error gets notified when an error occurs that prevents the utterance from being successfully spoken.
 //
 // https://developer.mozilla.org/docs/Web/Events/error_(SpeechSynthesisError)
@@ -364,6 +679,11 @@ func Error(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onError", listener)
 }
 
+// ErrorCapture is the same as Error, but gets notified in the capturing phase.
+func ErrorCapture(listener gr.Listener) *gr.EventListener {
+	return Error(listener).Capture()
+}
+
 // Focus gets notified when an element has received focus (does not bubble).
 //
 // https://developer.mozilla.org/docs/Web/Events/focus
@@ -371,6 +691,16 @@ func Focus(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onFocus", listener)
 }
 
+// FocusCapture is the same as Focus, but gets notified in the capturing phase.
+func FocusCapture(listener gr.Listener) *gr.EventListener {
+	return Focus(listener).Capture()
+}
+
+// FocusF is the same as Focus, but with a typed FocusEvent.
+func FocusF(listener func(*gr.FocusEvent)) *gr.EventListener {
+	return gr.NewEventListener("onFocus", gr.FocusListener(listener))
+}
+
 // FocusIn gets notified when an element is about to receive focus (bubbles).
 //
 // https://developer.mozilla.org/docs/Web/Events/focusin
@@ -378,6 +708,11 @@ func FocusIn(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onFocusIn", listener)
 }
 
+// FocusInCapture is the same as FocusIn, but gets notified in the capturing phase.
+func FocusInCapture(listener gr.Listener) *gr.EventListener {
+	return FocusIn(listener).Capture()
+}
+
 // FocusOut gets notified when an element is about to lose focus (bubbles).
 //
 // https://developer.mozilla.org/docs/Web/Events/focusout
@@ -385,6 +720,11 @@ func FocusOut(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onFocusOut", listener)
 }
 
+// FocusOutCapture is the same as FocusOut, but gets notified in the capturing phase.
+func FocusOutCapture(listener gr.Listener) *gr.EventListener {
+	return FocusOut(listener).Capture()
+}
+
 // FullScreenChange gets notified when an element was turned to fullscreen mode or back to normal mode.
 //
 // https://developer.mozilla.org/docs/Web/Events/fullscreenchange
@@ -392,6 +732,11 @@ func FullScreenChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onFullScreenChange", listener)
 }
 
+// FullScreenChangeCapture is the same as FullScreenChange, but gets notified in the capturing phase.
+func FullScreenChangeCapture(listener gr.Listener) *gr.EventListener {
+	return FullScreenChange(listener).Capture()
+}
+
 // FullScreenError gets notified when it was impossible to switch to fullscreen mode for technical reasons or because the permission was denied.
 //
 // https://developer.mozilla.org/docs/Web/Events/fullscreenerror
@@ -399,6 +744,11 @@ func FullScreenError(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onFullScreenError", listener)
 }
 
+// FullScreenErrorCapture is the same as FullScreenError, but gets notified in the capturing phase.
+func FullScreenErrorCapture(listener gr.Listener) *gr.EventListener {
+	return FullScreenError(listener).Capture()
+}
+
 // GamepadConnected gets notified when a gamepad has been connected.
 //
 // https://developer.mozilla.org/docs/Web/Events/gamepadconnected
@@ -406,6 +756,11 @@ func GamepadConnected(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onGamepadConnected", listener)
 }
 
+// GamepadConnectedCapture is the same as GamepadConnected, but gets notified in the capturing phase.
+func GamepadConnectedCapture(listener gr.Listener) *gr.EventListener {
+	return GamepadConnected(listener).Capture()
+}
+
 // GamepadDisconnected gets notified when a gamepad has been disconnected.
 //
 // https://developer.mozilla.org/docs/Web/Events/gamepaddisconnected
@@ -413,6 +768,11 @@ func GamepadDisconnected(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onGamepadDisconnected", listener)
 }
 
+// GamepadDisconnectedCapture is the same as GamepadDisconnected, but gets notified in the capturing phase.
+func GamepadDisconnectedCapture(listener gr.Listener) *gr.EventListener {
+	return GamepadDisconnected(listener).Capture()
+}
+
 // GotPointerCapture gets notified when element receives pointer capture.
 //
 // https://developer.mozilla.org/docs/Web/Events/gotpointercapture
@@ -420,6 +780,11 @@ func GotPointerCapture(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onGotPointerCapture", listener)
 }
 
+// GotPointerCaptureCapture is the same as GotPointerCapture, but gets notified in the capturing phase.
+func GotPointerCaptureCapture(listener gr.Listener) *gr.EventListener {
+	return GotPointerCapture(listener).Capture()
+}
+
 // HashChange gets notified when the fragment identifier of the URL has changed (the part of the URL after the #).
 //
 // https://developer.mozilla.org/docs/Web/Events/hashchange
@@ -427,6 +792,11 @@ func HashChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onHashChange", listener)
 }
 
+// HashChangeCapture is the same as HashChange, but gets notified in the capturing phase.
+func HashChangeCapture(listener gr.Listener) *gr.EventListener {
+	return HashChange(listener).Capture()
+}
+
 // Input gets notified when the value of an element changes or the content of an element with the attribute contenteditable is modified.
 //
 // https://developer.mozilla.org/docs/Web/Events/input
@@ -434,6 +804,11 @@ func Input(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onInput", listener)
 }
 
+// InputCapture is the same as Input, but gets notified in the capturing phase.
+func InputCapture(listener gr.Listener) *gr.EventListener {
+	return Input(listener).Capture()
+}
+
 // Invalid gets notified when a submittable element has been checked and doesn't satisfy its constraints.
 //
 // https://developer.mozilla.org/docs/Web/Events/invalid
@@ -441,6 +816,11 @@ func Invalid(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onInvalid", listener)
 }
 
+// InvalidCapture is the same as Invalid, but gets notified in the capturing phase.
+func InvalidCapture(listener gr.Listener) *gr.EventListener {
+	return Invalid(listener).Capture()
+}
+
 // KeyDown gets notified when a key is pressed down.
 //
 // https://developer.mozilla.org/docs/Web/Events/keydown
@@ -448,6 +828,16 @@ func KeyDown(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onKeyDown", listener)
 }
 
+// KeyDownCapture is the same as KeyDown, but gets notified in the capturing phase.
+func KeyDownCapture(listener gr.Listener) *gr.EventListener {
+	return KeyDown(listener).Capture()
+}
+
+// KeyDownK is the same as KeyDown, but with a typed KeyboardEvent.
+func KeyDownK(listener func(*gr.KeyboardEvent)) *gr.EventListener {
+	return gr.NewEventListener("onKeyDown", gr.KeyboardListener(listener))
+}
+
 // KeyPress gets notified when a key is pressed down and that key normally produces a character value (use input instead).
 //
 // https://developer.mozilla.org/docs/Web/Events/keypress
@@ -455,6 +845,16 @@ func KeyPress(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onKeyPress", listener)
 }
 
+// KeyPressCapture is the same as KeyPress, but gets notified in the capturing phase.
+func KeyPressCapture(listener gr.Listener) *gr.EventListener {
+	return KeyPress(listener).Capture()
+}
+
+// KeyPressK is the same as KeyPress, but with a typed KeyboardEvent.
+func KeyPressK(listener func(*gr.KeyboardEvent)) *gr.EventListener {
+	return gr.NewEventListener("onKeyPress", gr.KeyboardListener(listener))
+}
+
 // KeyUp gets notified when a key is released.
 //
 // https://developer.mozilla.org/docs/Web/Events/keyup
@@ -462,6 +862,16 @@ func KeyUp(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onKeyUp", listener)
 }
 
+// KeyUpCapture is the same as KeyUp, but gets notified in the capturing phase.
+func KeyUpCapture(listener gr.Listener) *gr.EventListener {
+	return KeyUp(listener).Capture()
+}
+
+// KeyUpK is the same as KeyUp, but with a typed KeyboardEvent.
+func KeyUpK(listener func(*gr.KeyboardEvent)) *gr.EventListener {
+	return gr.NewEventListener("onKeyUp", gr.KeyboardListener(listener))
+}
+
 // LanguageChange gets notified when (no documentation)
 //
 // https://developer.mozilla.org/docs/Web/Events/languagechange
@@ -469,6 +879,11 @@ func LanguageChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onLanguageChange", listener)
 }
 
+// LanguageChangeCapture is the same as LanguageChange, but gets notified in the capturing phase.
+func LanguageChangeCapture(listener gr.Listener) *gr.EventListener {
+	return LanguageChange(listener).Capture()
+}
+
 // LevelChange gets notified when the level attribute has been updated.
 //
 // https://developer.mozilla.org/docs/Web/Events/levelchange
@@ -476,6 +891,11 @@ func LevelChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onLevelChange", listener)
 }
 
+// LevelChangeCapture is the same as LevelChange, but gets notified in the capturing phase.
+func LevelChangeCapture(listener gr.Listener) *gr.EventListener {
+	return LevelChange(listener).Capture()
+}
+
 // Load gets notified when progression has been successful.
 //
 // https://developer.mozilla.org/docs/Web/Reference/Events/load_(ProgressEvent)
@@ -483,6 +903,11 @@ func Load(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onLoad", listener)
 }
 
+// LoadCapture is the same as Load, but gets notified in the capturing phase.
+func LoadCapture(listener gr.Listener) *gr.EventListener {
+	return Load(listener).Capture()
+}
+
 // LoadEnd gets notified when progress has stopped (after "error", "abort" or "load" have been dispatched).
 //
 // https://developer.mozilla.org/docs/Web/Events/loadend
@@ -490,6 +915,11 @@ func LoadEnd(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onLoadEnd", listener)
 }
 
+// LoadEndCapture is the same as LoadEnd, but gets notified in the capturing phase.
+func LoadEndCapture(listener gr.Listener) *gr.EventListener {
+	return LoadEnd(listener).Capture()
+}
+
 // LoadStart gets notified when progress has begun.
 //
 // https://developer.mozilla.org/docs/Web/Events/loadstart
@@ -497,6 +927,11 @@ func LoadStart(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onLoadStart", listener)
 }
 
+// LoadStartCapture is the same as LoadStart, but gets notified in the capturing phase.
+func LoadStartCapture(listener gr.Listener) *gr.EventListener {
+	return LoadStart(listener).Capture()
+}
+
 // LoadedData gets notified when the first frame of the media has finished loading.
 //
 // https://developer.mozilla.org/docs/Web/Events/loadeddata
@@ -504,6 +939,11 @@ func LoadedData(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onLoadedData", listener)
 }
 
+// LoadedDataCapture is the same as LoadedData, but gets notified in the capturing phase.
+func LoadedDataCapture(listener gr.Listener) *gr.EventListener {
+	return LoadedData(listener).Capture()
+}
+
 // LoadedMetadata gets notified when the metadata has been loaded.
 //
 // https://developer.mozilla.org/docs/Web/Events/loadedmetadata
@@ -511,6 +951,11 @@ func LoadedMetadata(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onLoadedMetadata", listener)
 }
 
+// LoadedMetadataCapture is the same as LoadedMetadata, but gets notified in the capturing phase.
+func LoadedMetadataCapture(listener gr.Listener) *gr.EventListener {
+	return LoadedMetadata(listener).Capture()
+}
+
 // LostPointerCapture gets notified when element lost pointer capture.
 //
 // https://developer.mozilla.org/docs/Web/Events/lostpointercapture
@@ -518,6 +963,11 @@ func LostPointerCapture(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onLostPointerCapture", listener)
 }
 
+// LostPointerCaptureCapture is the same as LostPointerCapture, but gets notified in the capturing phase.
+func LostPointerCaptureCapture(listener gr.Listener) *gr.EventListener {
+	return LostPointerCapture(listener).Capture()
+}
+
 // Mark gets notified when the spoken utterance reaches a named SSML "mark" tag.
 //
 // https://developer.mozilla.org/docs/Web/Events/mark
@@ -525,6 +975,11 @@ func Mark(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onMark", listener)
 }
 
+// MarkCapture is the same as Mark, but gets notified in the capturing phase.
+func MarkCapture(listener gr.Listener) *gr.EventListener {
+	return Mark(listener).Capture()
+}
+
 // Message gets notified when a message is received from a service worker, or a message is received in a service worker from another context.
 //
 // https://developer.mozilla.org/docs/Web/Events/message_(ServiceWorker)
@@ -532,6 +987,11 @@ func Message(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onMessage", listener)
 }
 
+// MessageCapture is the same as Message, but gets notified in the capturing phase.
+func MessageCapture(listener gr.Listener) *gr.EventListener {
+	return Message(listener).Capture()
+}
+
 // MouseDown gets notified when a pointing device button (usually a mouse) is pressed on an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/mousedown
@@ -539,6 +999,16 @@ func MouseDown(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onMouseDown", listener)
 }
 
+// MouseDownCapture is the same as MouseDown, but gets notified in the capturing phase.
+func MouseDownCapture(listener gr.Listener) *gr.EventListener {
+	return MouseDown(listener).Capture()
+}
+
+// MouseDownM is the same as MouseDown, but with a typed MouseEvent.
+func MouseDownM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onMouseDown", gr.MouseListener(listener))
+}
+
 // MouseEnter gets notified when a pointing device is moved onto the element that has the listener attached.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseenter
@@ -546,6 +1016,16 @@ func MouseEnter(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onMouseEnter", listener)
 }
 
+// MouseEnterCapture is the same as MouseEnter, but gets notified in the capturing phase.
+func MouseEnterCapture(listener gr.Listener) *gr.EventListener {
+	return MouseEnter(listener).Capture()
+}
+
+// MouseEnterM is the same as MouseEnter, but with a typed MouseEvent.
+func MouseEnterM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onMouseEnter", gr.MouseListener(listener))
+}
+
 // MouseLeave gets notified when a pointing device is moved off the element that has the listener attached.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseleave
@@ -553,6 +1033,16 @@ func MouseLeave(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onMouseLeave", listener)
 }
 
+// MouseLeaveCapture is the same as MouseLeave, but gets notified in the capturing phase.
+func MouseLeaveCapture(listener gr.Listener) *gr.EventListener {
+	return MouseLeave(listener).Capture()
+}
+
+// MouseLeaveM is the same as MouseLeave, but with a typed MouseEvent.
+func MouseLeaveM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onMouseLeave", gr.MouseListener(listener))
+}
+
 // MouseMove gets notified when a pointing device is moved over an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/mousemove
@@ -560,6 +1050,16 @@ func MouseMove(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onMouseMove", listener)
 }
 
+// MouseMoveCapture is the same as MouseMove, but gets notified in the capturing phase.
+func MouseMoveCapture(listener gr.Listener) *gr.EventListener {
+	return MouseMove(listener).Capture()
+}
+
+// MouseMoveM is the same as MouseMove, but with a typed MouseEvent.
+func MouseMoveM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onMouseMove", gr.MouseListener(listener))
+}
+
 // MouseOut gets notified when a pointing device is moved off the element that has the listener attached or off one of its children.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseout
@@ -567,6 +1067,16 @@ func MouseOut(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onMouseOut", listener)
 }
 
+// MouseOutCapture is the same as MouseOut, but gets notified in the capturing phase.
+func MouseOutCapture(listener gr.Listener) *gr.EventListener {
+	return MouseOut(listener).Capture()
+}
+
+// MouseOutM is the same as MouseOut, but with a typed MouseEvent.
+func MouseOutM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onMouseOut", gr.MouseListener(listener))
+}
+
 // MouseOver gets notified when a pointing device is moved onto the element that has the listener attached or onto one of its children.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseover
@@ -574,6 +1084,16 @@ func MouseOver(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onMouseOver", listener)
 }
 
+// MouseOverCapture is the same as MouseOver, but gets notified in the capturing phase.
+func MouseOverCapture(listener gr.Listener) *gr.EventListener {
+	return MouseOver(listener).Capture()
+}
+
+// MouseOverM is the same as MouseOver, but with a typed MouseEvent.
+func MouseOverM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onMouseOver", gr.MouseListener(listener))
+}
+
 // MouseUp gets notified when a pointing device button is released over an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/mouseup
@@ -581,6 +1101,16 @@ func MouseUp(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onMouseUp", listener)
 }
 
+// MouseUpCapture is the same as MouseUp, but gets notified in the capturing phase.
+func MouseUpCapture(listener gr.Listener) *gr.EventListener {
+	return MouseUp(listener).Capture()
+}
+
+// MouseUpM is the same as MouseUp, but with a typed MouseEvent.
+func MouseUpM(listener func(*gr.MouseEvent)) *gr.EventListener {
+	return gr.NewEventListener("onMouseUp", gr.MouseListener(listener))
+}
+
 // NoMatch gets notified when the speech recognition service returns a final result with no significant recognition.
 //
 // https://developer.mozilla.org/docs/Web/Events/nomatch
@@ -588,6 +1118,11 @@ func NoMatch(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onNoMatch", listener)
 }
 
+// NoMatchCapture is the same as NoMatch, but gets notified in the capturing phase.
+func NoMatchCapture(listener gr.Listener) *gr.EventListener {
+	return NoMatch(listener).Capture()
+}
+
 // NoUpdate gets notified when the manifest hadn't changed.
 //
 // https://developer.mozilla.org/docs/Web/Events/noupdate
@@ -595,6 +1130,11 @@ func NoUpdate(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onNoUpdate", listener)
 }
 
+// NoUpdateCapture is the same as NoUpdate, but gets notified in the capturing phase.
+func NoUpdateCapture(listener gr.Listener) *gr.EventListener {
+	return NoUpdate(listener).Capture()
+}
+
 // NotificationClick gets notified when a system notification spawned by ServiceWorkerRegistration.showNotification() has been clicked.
 //
 // https://developer.mozilla.org/docs/Web/Events/notificationclick
@@ -602,6 +1142,11 @@ func NotificationClick(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onNotificationClick", listener)
 }
 
+// NotificationClickCapture is the same as NotificationClick, but gets notified in the capturing phase.
+func NotificationClickCapture(listener gr.Listener) *gr.EventListener {
+	return NotificationClick(listener).Capture()
+}
+
 // Obsolete gets notified when the manifest was found to have become a 404 or 410 page, so the application cache is being deleted.
 //
 // https://developer.mozilla.org/docs/Web/Events/obsolete
@@ -609,6 +1154,11 @@ func Obsolete(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onObsolete", listener)
 }
 
+// ObsoleteCapture is the same as Obsolete, but gets notified in the capturing phase.
+func ObsoleteCapture(listener gr.Listener) *gr.EventListener {
+	return Obsolete(listener).Capture()
+}
+
 // Offline gets notified when the browser has lost access to the network.
 //
 // https://developer.mozilla.org/docs/Web/Events/offline
@@ -616,6 +1166,11 @@ func Offline(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onOffline", listener)
 }
 
+// OfflineCapture is the same as Offline, but gets notified in the capturing phase.
+func OfflineCapture(listener gr.Listener) *gr.EventListener {
+	return Offline(listener).Capture()
+}
+
 // Online gets notified when the browser has gained access to the network (but particular websites might be unreachable).
 //
 // https://developer.mozilla.org/docs/Web/Events/online
@@ -623,6 +1178,11 @@ func Online(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onOnline", listener)
 }
 
+// OnlineCapture is the same as Online, but gets notified in the capturing phase.
+func OnlineCapture(listener gr.Listener) *gr.EventListener {
+	return Online(listener).Capture()
+}
+
 // Open gets notified when an event source connection has been established.
 //
 // https://developer.mozilla.org/docs/Web/Reference/Events/open_serversentevents
@@ -630,6 +1190,11 @@ func Open(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onOpen", listener)
 }
 
+// OpenCapture is the same as Open, but gets notified in the capturing phase.
+func OpenCapture(listener gr.Listener) *gr.EventListener {
+	return Open(listener).Capture()
+}
+
 // OrientationChange gets notified when the orientation of the device (portrait/landscape) has changed
 //
 // https://developer.mozilla.org/docs/Web/Events/orientationchange
@@ -637,6 +1202,11 @@ func OrientationChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onOrientationChange", listener)
 }
 
+// OrientationChangeCapture is the same as OrientationChange, but gets notified in the capturing phase.
+func OrientationChangeCapture(listener gr.Listener) *gr.EventListener {
+	return OrientationChange(listener).Capture()
+}
+
 // PageHide gets notified when a session history entry is being traversed from.
 //
 // https://developer.mozilla.org/docs/Web/Events/pagehide
@@ -644,6 +1214,11 @@ func PageHide(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPageHide", listener)
 }
 
+// PageHideCapture is the same as PageHide, but gets notified in the capturing phase.
+func PageHideCapture(listener gr.Listener) *gr.EventListener {
+	return PageHide(listener).Capture()
+}
+
 // PageShow gets notified when a session history entry is being traversed to.
 //
 // https://developer.mozilla.org/docs/Web/Events/pageshow
@@ -651,6 +1226,11 @@ func PageShow(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPageShow", listener)
 }
 
+// PageShowCapture is the same as PageShow, but gets notified in the capturing phase.
+func PageShowCapture(listener gr.Listener) *gr.EventListener {
+	return PageShow(listener).Capture()
+}
+
 // Paste gets notified when data has been transferred from the system clipboard to the document.
 //
 // https://developer.mozilla.org/docs/Web/Events/paste
@@ -658,6 +1238,16 @@ func Paste(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPaste", listener)
 }
 
+// PasteCapture is the same as Paste, but gets notified in the capturing phase.
+func PasteCapture(listener gr.Listener) *gr.EventListener {
+	return Paste(listener).Capture()
+}
+
+// PasteC is the same as Paste, but with a typed ClipboardEvent.
+func PasteC(listener func(*gr.ClipboardEvent)) *gr.EventListener {
+	return gr.NewEventListener("onPaste", gr.ClipboardListener(listener))
+}
+
 // Pause gets notified when the utterance is paused part way through.
 //
 // https://developer.mozilla.org/docs/Web/Events/pause_(SpeechSynthesis)
@@ -665,6 +1255,11 @@ func Pause(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPause", listener)
 }
 
+// PauseCapture is the same as Pause, but gets notified in the capturing phase.
+func PauseCapture(listener gr.Listener) *gr.EventListener {
+	return Pause(listener).Capture()
+}
+
 // Play gets notified when playback has begun.
 //
 // https://developer.mozilla.org/docs/Web/Events/play
@@ -672,6 +1267,11 @@ func Play(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPlay", listener)
 }
 
+// PlayCapture is the same as Play, but gets notified in the capturing phase.
+func PlayCapture(listener gr.Listener) *gr.EventListener {
+	return Play(listener).Capture()
+}
+
 // Playing gets notified when playback is ready to start after having been paused or delayed due to lack of data.
 //
 // https://developer.mozilla.org/docs/Web/Events/playing
@@ -679,6 +1279,11 @@ func Playing(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPlaying", listener)
 }
 
+// PlayingCapture is the same as Playing, but gets notified in the capturing phase.
+func PlayingCapture(listener gr.Listener) *gr.EventListener {
+	return Playing(listener).Capture()
+}
+
 // PointerCancel gets notified when the pointer is unlikely to produce any more events.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointercancel
@@ -686,6 +1291,11 @@ func PointerCancel(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerCancel", listener)
 }
 
+// PointerCancelCapture is the same as PointerCancel, but gets notified in the capturing phase.
+func PointerCancelCapture(listener gr.Listener) *gr.EventListener {
+	return PointerCancel(listener).Capture()
+}
+
 // PointerDown gets notified when the pointer enters the active buttons state.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerdown
@@ -693,6 +1303,11 @@ func PointerDown(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerDown", listener)
 }
 
+// PointerDownCapture is the same as PointerDown, but gets notified in the capturing phase.
+func PointerDownCapture(listener gr.Listener) *gr.EventListener {
+	return PointerDown(listener).Capture()
+}
+
 // PointerEnter gets notified when pointing device is moved inside the hit-testing boundary.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerenter
@@ -700,6 +1315,11 @@ func PointerEnter(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerEnter", listener)
 }
 
+// PointerEnterCapture is the same as PointerEnter, but gets notified in the capturing phase.
+func PointerEnterCapture(listener gr.Listener) *gr.EventListener {
+	return PointerEnter(listener).Capture()
+}
+
 // PointerLeave gets notified when pointing device is moved out of the hit-testing boundary.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerleave
@@ -707,6 +1327,11 @@ func PointerLeave(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerLeave", listener)
 }
 
+// PointerLeaveCapture is the same as PointerLeave, but gets notified in the capturing phase.
+func PointerLeaveCapture(listener gr.Listener) *gr.EventListener {
+	return PointerLeave(listener).Capture()
+}
+
 // PointerLockChange gets notified when the pointer was locked or released.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerlockchange
@@ -714,6 +1339,11 @@ func PointerLockChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerLockChange", listener)
 }
 
+// PointerLockChangeCapture is the same as PointerLockChange, but gets notified in the capturing phase.
+func PointerLockChangeCapture(listener gr.Listener) *gr.EventListener {
+	return PointerLockChange(listener).Capture()
+}
+
 // PointerLockError gets notified when it was impossible to lock the pointer for technical reasons or because the permission was denied.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerlockerror
@@ -721,6 +1351,11 @@ func PointerLockError(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerLockError", listener)
 }
 
+// PointerLockErrorCapture is the same as PointerLockError, but gets notified in the capturing phase.
+func PointerLockErrorCapture(listener gr.Listener) *gr.EventListener {
+	return PointerLockError(listener).Capture()
+}
+
 // PointerMove gets notified when the pointer changed coordinates.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointermove
@@ -728,6 +1363,11 @@ func PointerMove(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerMove", listener)
 }
 
+// PointerMoveCapture is the same as PointerMove, but gets notified in the capturing phase.
+func PointerMoveCapture(listener gr.Listener) *gr.EventListener {
+	return PointerMove(listener).Capture()
+}
+
 // PointerOut gets notified when the pointing device moved out of hit-testing boundary or leaves detectable hover range.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerout
@@ -735,6 +1375,11 @@ func PointerOut(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerOut", listener)
 }
 
+// PointerOutCapture is the same as PointerOut, but gets notified in the capturing phase.
+func PointerOutCapture(listener gr.Listener) *gr.EventListener {
+	return PointerOut(listener).Capture()
+}
+
 // PointerOver gets notified when the pointing device is moved into the hit-testing boundary.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerover
@@ -742,6 +1387,11 @@ func PointerOver(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerOver", listener)
 }
 
+// PointerOverCapture is the same as PointerOver, but gets notified in the capturing phase.
+func PointerOverCapture(listener gr.Listener) *gr.EventListener {
+	return PointerOver(listener).Capture()
+}
+
 // PointerUp gets notified when the pointer leaves the active buttons state.
 //
 // https://developer.mozilla.org/docs/Web/Events/pointerup
@@ -749,6 +1399,11 @@ func PointerUp(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPointerUp", listener)
 }
 
+// PointerUpCapture is the same as PointerUp, but gets notified in the capturing phase.
+func PointerUpCapture(listener gr.Listener) *gr.EventListener {
+	return PointerUp(listener).Capture()
+}
+
 // PopState gets notified when a session history entry is being navigated to (in certain cases).
 //
 // https://developer.mozilla.org/docs/Web/Events/popstate
@@ -756,6 +1411,11 @@ func PopState(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPopState", listener)
 }
 
+// PopStateCapture is the same as PopState, but gets notified in the capturing phase.
+func PopStateCapture(listener gr.Listener) *gr.EventListener {
+	return PopState(listener).Capture()
+}
+
 // Progress gets notified when the user agent is downloading resources listed by the manifest.
 //
 // https://developer.mozilla.org/docs/Web/Reference/Events/progress_(appcache_event)
@@ -763,6 +1423,11 @@ func Progress(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onProgress", listener)
 }
 
+// ProgressCapture is the same as Progress, but gets notified in the capturing phase.
+func ProgressCapture(listener gr.Listener) *gr.EventListener {
+	return Progress(listener).Capture()
+}
+
 // Push gets notified when a Service Worker has received a push message.
 //
 // https://developer.mozilla.org/docs/Web/Events/push
@@ -770,6 +1435,11 @@ func Push(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPush", listener)
 }
 
+// PushCapture is the same as Push, but gets notified in the capturing phase.
+func PushCapture(listener gr.Listener) *gr.EventListener {
+	return Push(listener).Capture()
+}
+
 // PushSubscriptionChange gets notified when a PushSubscription has expired.
 //
 // https://developer.mozilla.org/docs/Web/Events/pushsubscriptionchange
@@ -777,6 +1447,11 @@ func PushSubscriptionChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onPushSubscriptionChange", listener)
 }
 
+// PushSubscriptionChangeCapture is the same as PushSubscriptionChange, but gets notified in the capturing phase.
+func PushSubscriptionChangeCapture(listener gr.Listener) *gr.EventListener {
+	return PushSubscriptionChange(listener).Capture()
+}
+
 // RateChange gets notified when the playback rate has changed.
 //
 // https://developer.mozilla.org/docs/Web/Events/ratechange
@@ -784,6 +1459,11 @@ func RateChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onRateChange", listener)
 }
 
+// RateChangeCapture is the same as RateChange, but gets notified in the capturing phase.
+func RateChangeCapture(listener gr.Listener) *gr.EventListener {
+	return RateChange(listener).Capture()
+}
+
 // ReadyStateChange gets notified when the readyState attribute of a document has changed.
 //
 // https://developer.mozilla.org/docs/Web/Events/readystatechange
@@ -791,6 +1471,11 @@ func ReadyStateChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onReadyStateChange", listener)
 }
 
+// ReadyStateChangeCapture is the same as ReadyStateChange, but gets notified in the capturing phase.
+func ReadyStateChangeCapture(listener gr.Listener) *gr.EventListener {
+	return ReadyStateChange(listener).Capture()
+}
+
 // RepeatEvent gets notified when a SMIL animation element is repeated.
 //
 // https://developer.mozilla.org/docs/Web/Events/repeatEvent
@@ -798,6 +1483,11 @@ func RepeatEvent(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onRepeatEvent", listener)
 }
 
+// RepeatEventCapture is the same as RepeatEvent, but gets notified in the capturing phase.
+func RepeatEventCapture(listener gr.Listener) *gr.EventListener {
+	return RepeatEvent(listener).Capture()
+}
+
 // Reset gets notified when a form is reset.
 //
 // https://developer.mozilla.org/docs/Web/Events/reset
@@ -805,6 +1495,11 @@ func Reset(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onReset", listener)
 }
 
+// ResetCapture is the same as Reset, but gets notified in the capturing phase.
+func ResetCapture(listener gr.Listener) *gr.EventListener {
+	return Reset(listener).Capture()
+}
+
 // Resize gets notified when the document view has been resized.
 //
 // https://developer.mozilla.org/docs/Web/Events/resize
@@ -812,6 +1507,11 @@ func Resize(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onResize", listener)
 }
 
+// ResizeCapture is the same as Resize, but gets notified in the capturing phase.
+func ResizeCapture(listener gr.Listener) *gr.EventListener {
+	return Resize(listener).Capture()
+}
+
 // ResourceTimingBufferFull gets notified when the browser's resource timing buffer is full.
 //
 // https://developer.mozilla.org/docs/Web/Events/resourcetimingbufferfull
@@ -819,6 +1519,11 @@ func ResourceTimingBufferFull(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onResourceTimingBufferFull", listener)
 }
 
+// ResourceTimingBufferFullCapture is the same as ResourceTimingBufferFull, but gets notified in the capturing phase.
+func ResourceTimingBufferFullCapture(listener gr.Listener) *gr.EventListener {
+	return ResourceTimingBufferFull(listener).Capture()
+}
+
 // Result gets notified when the speech recognition service returns a result — a word or phrase has been positively recognized and this has been communicated back to the app.
 //
 // https://developer.mozilla.org/docs/Web/Events/result
@@ -826,6 +1531,11 @@ func Result(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onResult", listener)
 }
 
+// ResultCapture is the same as Result, but gets notified in the capturing phase.
+func ResultCapture(listener gr.Listener) *gr.EventListener {
+	return Result(listener).Capture()
+}
+
 // Resume gets notified when a paused utterance is resumed.
 //
 // https://developer.mozilla.org/docs/Web/Events/resume
@@ -833,6 +1543,11 @@ func Resume(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onResume", listener)
 }
 
+// ResumeCapture is the same as Resume, but gets notified in the capturing phase.
+func ResumeCapture(listener gr.Listener) *gr.EventListener {
+	return Resume(listener).Capture()
+}
+
 // SVGAbort gets notified when page loading has been stopped before the SVG was loaded.
 //
 // https://developer.mozilla.org/docs/Web/Events/SVGAbort
@@ -840,6 +1555,11 @@ func SVGAbort(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSVGAbort", listener)
 }
 
+// SVGAbortCapture is the same as SVGAbort, but gets notified in the capturing phase.
+func SVGAbortCapture(listener gr.Listener) *gr.EventListener {
+	return SVGAbort(listener).Capture()
+}
+
 // SVGError gets notified when an error has occurred before the SVG was loaded.
 //
 // https://developer.mozilla.org/docs/Web/Events/SVGError
@@ -847,6 +1567,11 @@ func SVGError(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSVGError", listener)
 }
 
+// SVGErrorCapture is the same as SVGError, but gets notified in the capturing phase.
+func SVGErrorCapture(listener gr.Listener) *gr.EventListener {
+	return SVGError(listener).Capture()
+}
+
 // SVGLoad gets notified when an SVG document has been loaded and parsed.
 //
 // https://developer.mozilla.org/docs/Web/Events/SVGLoad
@@ -854,6 +1579,11 @@ func SVGLoad(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSVGLoad", listener)
 }
 
+// SVGLoadCapture is the same as SVGLoad, but gets notified in the capturing phase.
+func SVGLoadCapture(listener gr.Listener) *gr.EventListener {
+	return SVGLoad(listener).Capture()
+}
+
 // SVGResize gets notified when an SVG document is being resized.
 //
 // https://developer.mozilla.org/docs/Web/Events/SVGResize
@@ -861,6 +1591,11 @@ func SVGResize(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSVGResize", listener)
 }
 
+// SVGResizeCapture is the same as SVGResize, but gets notified in the capturing phase.
+func SVGResizeCapture(listener gr.Listener) *gr.EventListener {
+	return SVGResize(listener).Capture()
+}
+
 // SVGScroll gets notified when an SVG document is being scrolled.
 //
 // https://developer.mozilla.org/docs/Web/Events/SVGScroll
@@ -868,6 +1603,11 @@ func SVGScroll(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSVGScroll", listener)
 }
 
+// SVGScrollCapture is the same as SVGScroll, but gets notified in the capturing phase.
+func SVGScrollCapture(listener gr.Listener) *gr.EventListener {
+	return SVGScroll(listener).Capture()
+}
+
 // SVGUnload gets notified when an SVG document has been removed from a window or frame.
 //
 // https://developer.mozilla.org/docs/Web/Events/SVGUnload
@@ -875,6 +1615,11 @@ func SVGUnload(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSVGUnload", listener)
 }
 
+// SVGUnloadCapture is the same as SVGUnload, but gets notified in the capturing phase.
+func SVGUnloadCapture(listener gr.Listener) *gr.EventListener {
+	return SVGUnload(listener).Capture()
+}
+
 // SVGZoom gets notified when an SVG document is being zoomed.
 //
 // https://developer.mozilla.org/docs/Web/Events/SVGZoom
@@ -882,6 +1627,11 @@ func SVGZoom(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSVGZoom", listener)
 }
 
+// SVGZoomCapture is the same as SVGZoom, but gets notified in the capturing phase.
+func SVGZoomCapture(listener gr.Listener) *gr.EventListener {
+	return SVGZoom(listener).Capture()
+}
+
 // Scroll gets notified when the document view or an element has been scrolled.
 //
 // https://developer.mozilla.org/docs/Web/Events/scroll
@@ -889,6 +1639,11 @@ func Scroll(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onScroll", listener)
 }
 
+// ScrollCapture is the same as Scroll, but gets notified in the capturing phase.
+func ScrollCapture(listener gr.Listener) *gr.EventListener {
+	return Scroll(listener).Capture()
+}
+
 // Seeked gets notified when a seek operation completed.
 //
 // https://developer.mozilla.org/docs/Web/Events/seeked
@@ -896,6 +1651,11 @@ func Seeked(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSeeked", listener)
 }
 
+// SeekedCapture is the same as Seeked, but gets notified in the capturing phase.
+func SeekedCapture(listener gr.Listener) *gr.EventListener {
+	return Seeked(listener).Capture()
+}
+
 // Seeking gets notified when a seek operation began.
 //
 // https://developer.mozilla.org/docs/Web/Events/seeking
@@ -903,6 +1663,11 @@ func Seeking(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSeeking", listener)
 }
 
+// SeekingCapture is the same as Seeking, but gets notified in the capturing phase.
+func SeekingCapture(listener gr.Listener) *gr.EventListener {
+	return Seeking(listener).Capture()
+}
+
 // Select gets notified when some text is being selected.
 //
 // https://developer.mozilla.org/docs/Web/Events/select
@@ -910,6 +1675,11 @@ func Select(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSelect", listener)
 }
 
+// SelectCapture is the same as Select, but gets notified in the capturing phase.
+func SelectCapture(listener gr.Listener) *gr.EventListener {
+	return Select(listener).Capture()
+}
+
 // SelectStart gets notified when a selection just started.
 //
 // https://developer.mozilla.org/docs/Web/Events/selectstart
@@ -917,6 +1687,11 @@ func SelectStart(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSelectStart", listener)
 }
 
+// SelectStartCapture is the same as SelectStart, but gets notified in the capturing phase.
+func SelectStartCapture(listener gr.Listener) *gr.EventListener {
+	return SelectStart(listener).Capture()
+}
+
 // SelectionChange gets notified when the selection in the document has been changed.
 //
 // https://developer.mozilla.org/docs/Web/Events/selectionchange
@@ -924,6 +1699,11 @@ func SelectionChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSelectionChange", listener)
 }
 
+// SelectionChangeCapture is the same as SelectionChange, but gets notified in the capturing phase.
+func SelectionChangeCapture(listener gr.Listener) *gr.EventListener {
+	return SelectionChange(listener).Capture()
+}
+
 // Show gets notified when a contextmenu event was fired on/bubbled to an element that has a contextmenu attribute
 //
 // https://developer.mozilla.org/docs/Web/Events/show
@@ -931,6 +1711,11 @@ func Show(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onShow", listener)
 }
 
+// ShowCapture is the same as Show, but gets notified in the capturing phase.
+func ShowCapture(listener gr.Listener) *gr.EventListener {
+	return Show(listener).Capture()
+}
+
 // SoundEnd gets notified when any sound — recognisable speech or not — has stopped being detected.
 //
 // https://developer.mozilla.org/docs/Web/Events/soundend
@@ -938,6 +1723,11 @@ func SoundEnd(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSoundEnd", listener)
 }
 
+// SoundEndCapture is the same as SoundEnd, but gets notified in the capturing phase.
+func SoundEndCapture(listener gr.Listener) *gr.EventListener {
+	return SoundEnd(listener).Capture()
+}
+
 // SoundStart gets notified when any sound — recognisable speech or not — has been detected.
 //
 // https://developer.mozilla.org/docs/Web/Events/soundstart
@@ -945,6 +1735,11 @@ func SoundStart(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSoundStart", listener)
 }
 
+// SoundStartCapture is the same as SoundStart, but gets notified in the capturing phase.
+func SoundStartCapture(listener gr.Listener) *gr.EventListener {
+	return SoundStart(listener).Capture()
+}
+
 // SpeechEnd gets notified when speech recognised by the speech recognition service has stopped being detected.
 //
 // https://developer.mozilla.org/docs/Web/Events/speechend
@@ -952,6 +1747,11 @@ func SpeechEnd(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSpeechEnd", listener)
 }
 
+// SpeechEndCapture is the same as SpeechEnd, but gets notified in the capturing phase.
+func SpeechEndCapture(listener gr.Listener) *gr.EventListener {
+	return SpeechEnd(listener).Capture()
+}
+
 // SpeechStart gets notified when sound that is recognised by the speech recognition service as speech has been detected.
 //
 // https://developer.mozilla.org/docs/Web/Events/speechstart
@@ -959,6 +1759,11 @@ func SpeechStart(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSpeechStart", listener)
 }
 
+// SpeechStartCapture is the same as SpeechStart, but gets notified in the capturing phase.
+func SpeechStartCapture(listener gr.Listener) *gr.EventListener {
+	return SpeechStart(listener).Capture()
+}
+
 // Stalled gets notified when the user agent is trying to fetch media data, but data is unexpectedly not forthcoming.
 //
 // https://developer.mozilla.org/docs/Web/Events/stalled
@@ -966,6 +1771,11 @@ func Stalled(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onStalled", listener)
 }
 
+// StalledCapture is the same as Stalled, but gets notified in the capturing phase.
+func StalledCapture(listener gr.Listener) *gr.EventListener {
+	return Stalled(listener).Capture()
+}
+
 // Start gets notified when the utterance has begun to be spoken.
 //
 // https://developer.mozilla.org/docs/Web/Events/start_(SpeechSynthesis)
@@ -973,6 +1783,11 @@ func Start(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onStart", listener)
 }
 
+// StartCapture is the same as Start, but gets notified in the capturing phase.
+func StartCapture(listener gr.Listener) *gr.EventListener {
+	return Start(listener).Capture()
+}
+
 // Storage gets notified when a storage area (localStorage or sessionStorage) has changed.
 //
 // https://developer.mozilla.org/docs/Web/Events/storage
@@ -980,6 +1795,11 @@ func Storage(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onStorage", listener)
 }
 
+// StorageCapture is the same as Storage, but gets notified in the capturing phase.
+func StorageCapture(listener gr.Listener) *gr.EventListener {
+	return Storage(listener).Capture()
+}
+
 // Submit gets notified when a form is submitted.
 //
 // https://developer.mozilla.org/docs/Web/Events/submit
@@ -987,6 +1807,11 @@ func Submit(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSubmit", listener)
 }
 
+// SubmitCapture is the same as Submit, but gets notified in the capturing phase.
+func SubmitCapture(listener gr.Listener) *gr.EventListener {
+	return Submit(listener).Capture()
+}
+
 // Success gets notified when a request successfully completed.
 //
 // https://developer.mozilla.org/docs/Web/Reference/Events/success_indexedDB
@@ -994,6 +1819,11 @@ func Success(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSuccess", listener)
 }
 
+// SuccessCapture is the same as Success, but gets notified in the capturing phase.
+func SuccessCapture(listener gr.Listener) *gr.EventListener {
+	return Success(listener).Capture()
+}
+
 // Suspend gets notified when media data loading has been suspended.
 //
 // https://developer.mozilla.org/docs/Web/Events/suspend
@@ -1001,6 +1831,11 @@ func Suspend(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onSuspend", listener)
 }
 
+// SuspendCapture is the same as Suspend, but gets notified in the capturing phase.
+func SuspendCapture(listener gr.Listener) *gr.EventListener {
+	return Suspend(listener).Capture()
+}
+
 // TimeUpdate gets notified when the time indicated by the currentTime attribute has been updated.
 //
 // https://developer.mozilla.org/docs/Web/Events/timeupdate
@@ -1008,6 +1843,11 @@ func TimeUpdate(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onTimeUpdate", listener)
 }
 
+// TimeUpdateCapture is the same as TimeUpdate, but gets notified in the capturing phase.
+func TimeUpdateCapture(listener gr.Listener) *gr.EventListener {
+	return TimeUpdate(listener).Capture()
+}
+
 // Timeout gets notified when (no documentation)
 //
 // https://developer.mozilla.org/docs/Web/Events/timeout
@@ -1015,6 +1855,11 @@ func Timeout(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onTimeout", listener)
 }
 
+// TimeoutCapture is the same as Timeout, but gets notified in the capturing phase.
+func TimeoutCapture(listener gr.Listener) *gr.EventListener {
+	return Timeout(listener).Capture()
+}
+
 // TouchCancel gets notified when a touch point has been disrupted in an implementation-specific manners (too many touch points for example).
 //
 // https://developer.mozilla.org/docs/Web/Events/touchcancel
@@ -1022,6 +1867,16 @@ func TouchCancel(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onTouchCancel", listener)
 }
 
+// TouchCancelCapture is the same as TouchCancel, but gets notified in the capturing phase.
+func TouchCancelCapture(listener gr.Listener) *gr.EventListener {
+	return TouchCancel(listener).Capture()
+}
+
+// TouchCancelT is the same as TouchCancel, but with a typed TouchEvent.
+func TouchCancelT(listener func(*gr.TouchEvent)) *gr.EventListener {
+	return gr.NewEventListener("onTouchCancel", gr.TouchListener(listener))
+}
+
 // TouchEnd gets notified when a touch point is removed from the touch surface.
 //
 // https://developer.mozilla.org/docs/Web/Events/touchend
@@ -1029,6 +1884,16 @@ func TouchEnd(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onTouchEnd", listener)
 }
 
+// TouchEndCapture is the same as TouchEnd, but gets notified in the capturing phase.
+func TouchEndCapture(listener gr.Listener) *gr.EventListener {
+	return TouchEnd(listener).Capture()
+}
+
+// TouchEndT is the same as TouchEnd, but with a typed TouchEvent.
+func TouchEndT(listener func(*gr.TouchEvent)) *gr.EventListener {
+	return gr.NewEventListener("onTouchEnd", gr.TouchListener(listener))
+}
+
 // TouchEnter gets notified when a touch point is moved onto the interactive area of an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/touchenter
@@ -1036,6 +1901,11 @@ func TouchEnter(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onTouchEnter", listener)
 }
 
+// TouchEnterCapture is the same as TouchEnter, but gets notified in the capturing phase.
+func TouchEnterCapture(listener gr.Listener) *gr.EventListener {
+	return TouchEnter(listener).Capture()
+}
+
 // TouchLeave gets notified when a touch point is moved off the interactive area of an element.
 //
 // https://developer.mozilla.org/docs/Web/Events/touchleave
@@ -1043,6 +1913,11 @@ func TouchLeave(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onTouchLeave", listener)
 }
 
+// TouchLeaveCapture is the same as TouchLeave, but gets notified in the capturing phase.
+func TouchLeaveCapture(listener gr.Listener) *gr.EventListener {
+	return TouchLeave(listener).Capture()
+}
+
 // TouchMove gets notified when a touch point is moved along the touch surface.
 //
 // https://developer.mozilla.org/docs/Web/Events/touchmove
@@ -1050,6 +1925,16 @@ func TouchMove(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onTouchMove", listener)
 }
 
+// TouchMoveCapture is the same as TouchMove, but gets notified in the capturing phase.
+func TouchMoveCapture(listener gr.Listener) *gr.EventListener {
+	return TouchMove(listener).Capture()
+}
+
+// TouchMoveT is the same as TouchMove, but with a typed TouchEvent.
+func TouchMoveT(listener func(*gr.TouchEvent)) *gr.EventListener {
+	return gr.NewEventListener("onTouchMove", gr.TouchListener(listener))
+}
+
 // TouchStart gets notified when a touch point is placed on the touch surface.
 //
 // https://developer.mozilla.org/docs/Web/Events/touchstart
@@ -1057,6 +1942,16 @@ func TouchStart(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onTouchStart", listener)
 }
 
+// TouchStartCapture is the same as TouchStart, but gets notified in the capturing phase.
+func TouchStartCapture(listener gr.Listener) *gr.EventListener {
+	return TouchStart(listener).Capture()
+}
+
+// TouchStartT is the same as TouchStart, but with a typed TouchEvent.
+func TouchStartT(listener func(*gr.TouchEvent)) *gr.EventListener {
+	return gr.NewEventListener("onTouchStart", gr.TouchListener(listener))
+}
+
 // TransitionEnd gets notified when a CSS transition has completed.
 //
 // https://developer.mozilla.org/docs/Web/Events/transitionend
@@ -1064,6 +1959,11 @@ func TransitionEnd(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onTransitionEnd", listener)
 }
 
+// TransitionEndCapture is the same as TransitionEnd, but gets notified in the capturing phase.
+func TransitionEndCapture(listener gr.Listener) *gr.EventListener {
+	return TransitionEnd(listener).Capture()
+}
+
 // Unload gets notified when the document or a dependent resource is being unloaded.
 //
 // https://developer.mozilla.org/docs/Web/Events/unload
@@ -1071,6 +1971,11 @@ func Unload(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onUnload", listener)
 }
 
+// UnloadCapture is the same as Unload, but gets notified in the capturing phase.
+func UnloadCapture(listener gr.Listener) *gr.EventListener {
+	return Unload(listener).Capture()
+}
+
 // UpdateReady gets notified when the resources listed in the manifest have been newly redownloaded, and the script can use swapCache() to switch to the new cache.
 //
 // https://developer.mozilla.org/docs/Web/Events/updateready
@@ -1078,6 +1983,11 @@ func UpdateReady(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onUpdateReady", listener)
 }
 
+// UpdateReadyCapture is the same as UpdateReady, but gets notified in the capturing phase.
+func UpdateReadyCapture(listener gr.Listener) *gr.EventListener {
+	return UpdateReady(listener).Capture()
+}
+
 // UpgradeNeeded gets notified when an attempt was made to open a database with a version number higher than its current version. A versionchange transaction has been created.
 //
 // https://developer.mozilla.org/docs/Web/Reference/Events/upgradeneeded_indexedDB
@@ -1085,6 +1995,11 @@ func UpgradeNeeded(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onUpgradeNeeded", listener)
 }
 
+// UpgradeNeededCapture is the same as UpgradeNeeded, but gets notified in the capturing phase.
+func UpgradeNeededCapture(listener gr.Listener) *gr.EventListener {
+	return UpgradeNeeded(listener).Capture()
+}
+
 // UserProximity gets notified when fresh data is available from a proximity sensor (indicates whether the nearby object is near the device or not).
 //
 // https://developer.mozilla.org/docs/Web/Events/userproximity
@@ -1092,6 +2007,11 @@ func UserProximity(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onUserProximity", listener)
 }
 
+// UserProximityCapture is the same as UserProximity, but gets notified in the capturing phase.
+func UserProximityCapture(listener gr.Listener) *gr.EventListener {
+	return UserProximity(listener).Capture()
+}
+
 // VersionChange gets notified when a versionchange transaction completed.
 //
 // https://developer.mozilla.org/docs/Web/Reference/Events/versionchange_indexedDB
@@ -1099,6 +2019,11 @@ func VersionChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onVersionChange", listener)
 }
 
+// VersionChangeCapture is the same as VersionChange, but gets notified in the capturing phase.
+func VersionChangeCapture(listener gr.Listener) *gr.EventListener {
+	return VersionChange(listener).Capture()
+}
+
 // VisibilityChange gets notified when the content of a tab has become visible or has been hidden.
 //
 // https://developer.mozilla.org/docs/Web/Events/visibilitychange
@@ -1106,6 +2031,11 @@ func VisibilityChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onVisibilityChange", listener)
 }
 
+// VisibilityChangeCapture is the same as VisibilityChange, but gets notified in the capturing phase.
+func VisibilityChangeCapture(listener gr.Listener) *gr.EventListener {
+	return VisibilityChange(listener).Capture()
+}
+
 // VoicesChanged gets notified when the list of SpeechSynthesisVoice objects that would be returned by the SpeechSynthesis.getVoices() method has changed (when the voiceschanged event fires.)
 //
 // https://developer.mozilla.org/docs/Web/Events/voiceschanged
@@ -1113,6 +2043,11 @@ func VoicesChanged(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onVoicesChanged", listener)
 }
 
+// VoicesChangedCapture is the same as VoicesChanged, but gets notified in the capturing phase.
+func VoicesChangedCapture(listener gr.Listener) *gr.EventListener {
+	return VoicesChanged(listener).Capture()
+}
+
 // VolumeChange gets notified when the volume has changed.
 //
 // https://developer.mozilla.org/docs/Web/Events/volumechange
@@ -1120,6 +2055,11 @@ func VolumeChange(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onVolumeChange", listener)
 }
 
+// VolumeChangeCapture is the same as VolumeChange, but gets notified in the capturing phase.
+func VolumeChangeCapture(listener gr.Listener) *gr.EventListener {
+	return VolumeChange(listener).Capture()
+}
+
 // Waiting gets notified when playback has stopped because of a temporary lack of data.
 //
 // https://developer.mozilla.org/docs/Web/Events/waiting
@@ -1127,9 +2067,24 @@ func Waiting(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onWaiting", listener)
 }
 
+// WaitingCapture is the same as Waiting, but gets notified in the capturing phase.
+func WaitingCapture(listener gr.Listener) *gr.EventListener {
+	return Waiting(listener).Capture()
+}
+
 // Wheel gets notified when a wheel button of a pointing device is rotated in any direction.
 //
 // https://developer.mozilla.org/docs/Web/Events/wheel
 func Wheel(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("onWheel", listener)
 }
+
+// WheelCapture is the same as Wheel, but gets notified in the capturing phase.
+func WheelCapture(listener gr.Listener) *gr.EventListener {
+	return Wheel(listener).Capture()
+}
+
+// WheelW is the same as Wheel, but with a typed WheelEvent.
+func WheelW(listener func(*gr.WheelEvent)) *gr.EventListener {
+	return gr.NewEventListener("onWheel", gr.WheelListener(listener))
+}
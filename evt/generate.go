@@ -23,7 +23,10 @@ limitations under the License.
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"go/format"
+	"io/ioutil"
 	"os"
 	"sort"
 	"strings"
@@ -139,6 +142,40 @@ func main() {
 		"volumechange":             "VolumeChange",
 	}
 
+	// The events with a typed listener variant, e.g. ClickM(func(*gr.MouseEvent)).
+	typedEvents := map[string]string{
+		"Click":       "Mouse",
+		"ContextMenu": "Mouse",
+		"DoubleClick": "Mouse",
+		"MouseDown":   "Mouse",
+		"MouseEnter":  "Mouse",
+		"MouseLeave":  "Mouse",
+		"MouseMove":   "Mouse",
+		"MouseOut":    "Mouse",
+		"MouseOver":   "Mouse",
+		"MouseUp":     "Mouse",
+		"KeyDown":     "Keyboard",
+		"KeyPress":    "Keyboard",
+		"KeyUp":       "Keyboard",
+		"TouchCancel": "Touch",
+		"TouchEnd":    "Touch",
+		"TouchMove":   "Touch",
+		"TouchStart":  "Touch",
+		"Wheel":       "Wheel",
+		"Copy":        "Clipboard",
+		"Cut":         "Clipboard",
+		"Paste":       "Clipboard",
+		"Blur":        "Focus",
+		"Focus":       "Focus",
+		"Drag":        "Drag",
+		"DragEnd":     "Drag",
+		"DragEnter":   "Drag",
+		"DragLeave":   "Drag",
+		"DragOver":    "Drag",
+		"DragStart":   "Drag",
+		"Drop":        "Drag",
+	}
+
 	doc, err := goquery.NewDocument("https://developer.mozilla.org/en-US/docs/Web/Events")
 	if err != nil {
 		panic(err)
@@ -200,6 +237,54 @@ func %s(listener gr.Listener) *gr.EventListener {
 	return gr.NewEventListener("on%s", listener)
 }
 `, name, firstToLower(e.Desc), e.Link[6:], name, name)
+
+		fmt.Fprintf(file, `
+// %sCapture is the same as %s, but gets notified in the capturing phase.
+func %sCapture(listener gr.Listener) *gr.EventListener {
+	return %s(listener).Capture()
+}
+`, name, name, name, name)
+
+		if typ, ok := typedEvents[name]; ok {
+			fmt.Fprintf(file, `
+// %s%s is the same as %s, but with a typed %sEvent.
+func %s%s(listener func(*gr.%sEvent)) *gr.EventListener {
+	return gr.NewEventListener("on%s", gr.%sListener(listener))
+}
+`, name, typ[:1], name, typ, name, typ[:1], typ, name, typ)
+		}
+	}
+
+	writeDOMEventNames(names, events)
+}
+
+// writeDOMEventNames writes the table used to look up the DOM event name from the
+// React property name in the gr package, see This.ListenWindow.
+func writeDOMEventNames(names []string, events map[string]*Event) {
+	var buf bytes.Buffer
+
+	fmt.Fprint(&buf, `// Generated by evt/generate.go from "Event reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web/Events, licensed under CC-BY-SA 2.5.
+
+package gr
+
+// domEventNames maps the React property names used by the evt package to the
+// DOM event names, e.g. "onDoubleClick" to "dblclick".
+var domEventNames = map[string]string{
+`)
+
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", "on"+name, events[name].Name)
+	}
+
+	fmt.Fprint(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err := ioutil.WriteFile("../domevents.autogen.go", src, 0644); err != nil {
+		panic(err)
 	}
 }
 
@@ -22,11 +22,15 @@ func main() {
 }
 
 type gist struct {
-	URL         string `json:"url"`
-	ID          string `json:"id"`
-	HTMLURL     string `json:"html_url"`
-	CreatedAt   string `json:"created_at"`
-	Description string `json:"description"`
+	URL         string `json:"url" gr:"url"`
+	ID          string `json:"id" gr:"id"`
+	HTMLURL     string `json:"html_url" gr:"htmlURL"`
+	CreatedAt   string `json:"created_at" gr:"createdAt"`
+	Description string `json:"description" gr:"description"`
+}
+
+type gistsState struct {
+	Gists []gist `gr:"gists"`
 }
 
 type userGists struct {
@@ -38,19 +42,13 @@ func (g userGists) Render() gr.Component {
 
 	elem := el.Div()
 
-	if s := g.State().Interface("gists"); s != nil {
-		// The nice Gist type is lost once we entered the JavaScript world.
-		//
-		// What we get now is:
-		//
-		// []interface{} with the individual Gists as map[string]interface{}
-		//
-		// Let that serve as a note to self that this may not be the optimal way.
-		// I imagine most of the UI will happen in JavaScript and the business logic
-		// and here in Go "all" the orchestration, including injecting data required
-		// by the components.
-		gists := s.([]interface{})
+	var state gistsState
 
+	if err := g.StateInto(&state); err != nil {
+		panic(err)
+	}
+
+	if state.Gists != nil {
 		table := el.Table(
 			gr.CSS("table", "table-striped"),
 			gr.Style("width", "50%"),
@@ -61,7 +59,7 @@ func (g userGists) Render() gr.Component {
 
 		body := el.TableBody()
 
-		for _, g := range gists {
+		for _, g := range state.Gists {
 			tr := tableRow(g)
 			tr.Modify(body)
 		}
@@ -75,13 +73,11 @@ func (g userGists) Render() gr.Component {
 
 }
 
-func tableRow(i interface{}) *gr.Element {
-	gist := i.(map[string]interface{})
-
+func tableRow(g gist) *gr.Element {
 	return el.TableRow(
-		el.TableData(gr.Text(gist["Description"])),
+		el.TableData(gr.Text(g.Description)),
 		el.TableData(
-			el.Anchor(attr.HRef(gist["HtmlUrl"]),
+			el.Anchor(attr.HRef(g.HTMLURL),
 				attr.Target("_blank"), gr.Text("View"))),
 	)
 }
@@ -94,9 +90,20 @@ func (g userGists) ComponentDidMount() {
 
 	// Note that ComponentDidMount is assumed to block, so no need to spin up a
 	// goroutine for this.
-	resp, err := http.Get("https://api.github.com/users/bradfitz/gists")
+	req, err := http.NewRequest("GET", "https://api.github.com/users/bradfitz/gists", nil)
+
+	if err != nil {
+		panic(err)
+	}
+
+	// The request is cancelled if the component is unmounted before it completes.
+	ctx := g.Lifetime()
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
 
 	if err != nil {
+		if ctx.Err() != nil {
+			return
+		}
 		panic(err)
 	}
 
@@ -108,13 +115,14 @@ func (g userGists) ComponentDidMount() {
 		panic(err)
 	}
 
-	g.SetState(gr.State{"gists": gists})
+	if err := g.SetStateFrom(gistsState{Gists: gists}); err != nil {
+		panic(err)
+	}
 }
 
 // Implements the ComponentWillUnmount interface
 func (g userGists) ComponentWillUnmount() {
 	println("ComponentWillUnmount")
-	// TODO(bep): HTTP Cancelation
 }
 
 // Implements the ShouldComponentUpdate interface.
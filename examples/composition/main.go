@@ -15,7 +15,7 @@ import (
 func main() {
 	var (
 		start     = time.Now().Unix()
-		component = gr.New(new(elapser))
+		component = gr.New(new(elapser), gr.Pure()) // Only re-render when the props change.
 	)
 
 	gr.RenderLoop(func() {
@@ -42,11 +42,6 @@ func (e elapser) Render() gr.Component {
 	return examples.Example("Component Composition", elem)
 }
 
-// Implements the ShouldComponentUpdate interface.
-func (e elapser) ShouldComponentUpdate(next gr.Cops) bool {
-	return e.Props().HasChanged(next.Props, "elapsed")
-}
-
 func (e elapser) ComponentDidMount() {
 	println("Elapser: ComponentDidMount")
 }
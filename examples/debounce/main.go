@@ -5,7 +5,6 @@ import (
 
 	"fmt"
 
-	"github.com/bep/debounce"
 	"github.com/bep/gr"
 	"github.com/bep/gr/attr"
 	"github.com/bep/gr/el"
@@ -47,7 +46,8 @@ func (m mouseTracker) Render() gr.Component {
 		el.Paragraph(el.Anchor(
 			attr.HRef("https://davidwalsh.name/javascript-debounce-function"),
 			gr.Text("Debounce Function Explained"))),
-		evt.MouseMove(debounceMouseListener),
+		// Only update the UI when no new events have been received for >= 200 ms.
+		evt.MouseMoveM(mouseListener).Debounce(200*time.Millisecond),
 	)
 
 	return examples.Example("Debounce", elem)
@@ -61,23 +61,7 @@ func (m mouseTracker) ShouldComponentUpdate(next gr.Cops) bool {
 	return m.State().HasChanged(next.State, "mouseX", "mouseY")
 }
 
-var (
-	// Only update the UI when no new events have been received for >= 200 ms.
-	debouncer, _                      = debounce.New(200 * time.Millisecond)
-	debounceMouseListener gr.Listener = func(e *gr.Event) {
-
-		// React recycles events - so extract early.
-		// For the mouse API, see https://developer.mozilla.org/en-US/docs/Web/API/MouseEvent
-		// Note the browser compatibility notes.
-		clientX := e.Int("screenX")
-		clientY := e.Int("screenY")
-
-		f := func() {
-			counter := e.This.State().Int("counter") + 1
-			e.This.SetState(gr.State{"mouseX": clientX, "mouseY": clientY, "counter": counter})
-		}
-
-		debouncer(f)
-
-	}
-)
+func mouseListener(e *gr.MouseEvent) {
+	counter := e.This.State().Int("counter") + 1
+	e.This.SetState(gr.State{"mouseX": e.ScreenX(), "mouseY": e.ScreenY(), "counter": counter})
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+const defaultFuncDisplayName = "FuncComponent"
+
+// RenderFunc is the signature of a function component's render func.
+type RenderFunc func(props Props, children *Children) Component
+
+// NewFunc creates a new stateless function component from the given render func.
+// This is cheaper than New, as no class is created and no reflection is involved,
+// so it is a good fit for presentational components.
+//
+// Event listeners in the rendered tree get a nil Event.This, as there is no this
+// context in function components.
+//
+// Of the options, DisplayName is recommended, as Go funcs have no usable name.
+// See Memo for a function component that skips rendering when its props are unchanged.
+//
+// See https://reactjs.org/docs/components-and-props.html#functional-and-class-components
+func NewFunc(f RenderFunc, options ...Option) *ReactComponent {
+	return newFuncComponent(func(arguments []*js.Object) Component {
+		var props *js.Object
+		if len(arguments) > 0 {
+			props = arguments[0]
+		}
+		return f(objectToMap(props), childrenFromProps(props))
+	}, nil, options)
+}
+
+// newFuncComponent creates a function component from the given render func, which
+// receives the arguments from React. If wrap is set, the JavaScript func is
+// wrapped by it, e.g. React.forwardRef.
+func newFuncComponent(render func(arguments []*js.Object) Component, wrap func(fn *js.Object) *js.Object, options []Option) *ReactComponent {
+	var (
+		root        = &ReactComponent{}
+		displayName = defaultFuncDisplayName
+	)
+
+	fn := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		defer rethrowAsJSError(displayName)
+		return prepareRendered(nil, displayName, render(arguments), nil)
+	})
+
+	if wrap != nil {
+		fn = wrap(fn)
+	}
+
+	// The static properties supported by function components, propTypes etc., are
+	// set directly on the func, or on the wrapper if set.
+	root.reactClass = &reactClass{Object: fn}
+	root.funcComponent = true
+	root.reactClass.displayName = displayName
+
+	root.applyOptions(options, true)
+
+	displayName = root.reactClass.displayName
+
+	root.handleOptionsOnPrepare()
+
+	root.node = createFactory(fn)
+
+	root.applyOptions(options, false)
+
+	root.handleOptionsOnCreate()
+
+	return root
+}
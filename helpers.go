@@ -85,7 +85,7 @@ func objectToMap(o *js.Object) map[string]interface{} {
 
 	m := make(map[string]interface{})
 
-	if o == js.Undefined {
+	if isNullOrUndefined(o) {
 		return m
 	}
 
@@ -0,0 +1,227 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Hooks let function components (see NewFunc) have state and side effects.
+// They must be called unconditionally and in the same order on every render,
+// and only from the render func of a function component.
+// Hooks require React 16.8 or newer.
+//
+// Values stored by the hooks keep their Go types, e.g. a stored int comes back as
+// an int and not as a *js.Object or a float64.
+//
+// See https://reactjs.org/docs/hooks-reference.html
+
+// StateHook holds a state value and its setter, see UseState.
+type StateHook struct {
+	value   interface{}
+	wrapped *js.Object
+	setter  *js.Object
+}
+
+// UseState returns a state value initialized with the given initial value.
+// Calling Set will store the new value and re-render the component.
+func UseState(initial interface{}) *StateHook {
+	res := callHook("useState", wrapGo(initial))
+	return &StateHook{value: unwrapGo(res.Index(0)), wrapped: res.Index(0), setter: res.Index(1)}
+}
+
+// Value returns the current state value.
+func (h *StateHook) Value() interface{} {
+	return h.value
+}
+
+// Int returns the current state value as an int, or 0 if it is not an int.
+func (h *StateHook) Int() int {
+	i, _ := h.value.(int)
+	return i
+}
+
+// String returns the current state value as a string, or "" if it is not a string.
+func (h *StateHook) String() string {
+	s, _ := h.value.(string)
+	return s
+}
+
+// Bool returns the current state value as a bool, or false if it is not a bool.
+func (h *StateHook) Bool() bool {
+	b, _ := h.value.(bool)
+	return b
+}
+
+// Set sets a new state value and schedules a re-render.
+// Setting the current value again does not re-render.
+func (h *StateHook) Set(v interface{}) {
+	if sameValue(v, h.value) {
+		// React compares state by identity.
+		h.setter.Invoke(h.wrapped)
+		return
+	}
+	h.setter.Invoke(wrapGo(v))
+}
+
+// Update sets a new state value computed from the previous, which is safer than Set
+// when the new value depends on the previous and there may be pending updates.
+func (h *StateHook) Update(f func(prev interface{}) interface{}) {
+	h.setter.Invoke(js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		var prev interface{}
+		if len(arguments) > 0 {
+			prev = unwrapGo(arguments[0])
+		}
+		return wrapGo(f(prev))
+	}))
+}
+
+// UseEffect runs the given effect after the component has rendered.
+// The effect may return a cleanup func (or nil), which is run before the effect runs
+// again and when the component unmounts.
+//
+// With nil deps the effect runs after every render; with an empty slice it runs once
+// after the first render. Otherwise it runs when any of the deps have changed.
+// React compares the deps by identity, so use numbers, strings, bools or pointers.
+func UseEffect(effect func() func(), deps []interface{}) {
+	useEffect("useEffect", effect, deps)
+}
+
+// UseLayoutEffect is identical to UseEffect, but it runs synchronously after all DOM
+// mutations and before the browser paints.
+func UseLayoutEffect(effect func() func(), deps []interface{}) {
+	useEffect("useLayoutEffect", effect, deps)
+}
+
+func useEffect(hook string, effect func() func(), deps []interface{}) {
+	f := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		defer rethrowAsJSError(hook)
+
+		cleanup := effect()
+		if cleanup == nil {
+			return js.Undefined
+		}
+
+		return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			defer rethrowAsJSError(hook + " cleanup")
+			cleanup()
+			return js.Undefined
+		})
+	})
+
+	if deps == nil {
+		callHook(hook, f)
+		return
+	}
+
+	callHook(hook, f, deps)
+}
+
+// RefHook is a mutable container that lives for the full lifetime of the component,
+// see UseRef.
+type RefHook struct {
+	*js.Object
+}
+
+// UseRef returns a RefHook with its value initialized with the given value.
+// Changing the value will not re-render the component.
+//
+// The RefHook can also be passed to attr.Ref to get a reference to a DOM node,
+// see DOM.
+func UseRef(initial interface{}) *RefHook {
+	return &RefHook{Object: callHook("useRef", wrapGo(initial))}
+}
+
+// Current returns the current value.
+func (r *RefHook) Current() interface{} {
+	return unwrapGo(r.Get("current"))
+}
+
+// SetCurrent sets the current value.
+func (r *RefHook) SetCurrent(v interface{}) {
+	r.Set("current", wrapGo(v))
+}
+
+// DOM returns the current value as a JavaScript object, e.g. a DOM node when
+// used with attr.Ref.
+func (r *RefHook) DOM() *js.Object {
+	return r.Get("current")
+}
+
+// UseMemo returns the memoized value created by compute. The value is only
+// recomputed when any of the deps have changed, see UseEffect for a description of deps.
+func UseMemo(compute func() interface{}, deps []interface{}) interface{} {
+	f := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		defer rethrowAsJSError("useMemo")
+		return wrapGo(compute())
+	})
+
+	if deps == nil {
+		return unwrapGo(callHook("useMemo", f))
+	}
+
+	return unwrapGo(callHook("useMemo", f, deps))
+}
+
+// UseContext returns the current value of the given ContextType, see CreateContext.
+func UseContext(ct *ContextType) interface{} {
+	return unwrapGo(callHook("useContext", ct.Object))
+}
+
+func callHook(name string, args ...interface{}) *js.Object {
+	if react.Get(name) == js.Undefined {
+		panic("Hooks require React 16.8 or newer")
+	}
+	return react.Call(name, args...)
+}
+
+// wrapGo wraps a Go value so it survives a round trip through JavaScript with its
+// Go type intact, see unwrapGo.
+func wrapGo(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	return js.MakeWrapper(v)
+}
+
+// sameValue reports whether a and b are equal, false if they are not comparable.
+func sameValue(a, b interface{}) (same bool) {
+	if a == nil || b == nil {
+		return a == b
+	}
+	ta := reflect.TypeOf(a)
+	if ta != reflect.TypeOf(b) || !ta.Comparable() {
+		return false
+	}
+	// Structs with interface fields holding e.g. slices pass the check above
+	// but panic when compared.
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+	return a == b
+}
+
+func unwrapGo(o *js.Object) interface{} {
+	if isNullOrUndefined(o) {
+		return nil
+	}
+	return o.Interface()
+}
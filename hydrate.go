@@ -0,0 +1,69 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// MarshalProps serializes the props to JSON, so they can be shipped from the server
+// to the client for hydration.
+func MarshalProps(props Props) (string, error) {
+	if props == nil {
+		props = Props{}
+	}
+	b, err := json.Marshal(props)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// UnmarshalProps decodes props serialized with MarshalProps.
+// Note that, as with all JSON, numbers are decoded as float64.
+func UnmarshalProps(s string) (Props, error) {
+	props := Props{}
+	if err := json.Unmarshal([]byte(s), &props); err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
+// PropsScript returns a script element with the JSON serialized props, to be
+// included in the server rendered page and read on the client with PropsFromScript.
+func PropsScript(scriptID string, props Props) (string, error) {
+	s, err := MarshalProps(props)
+	if err != nil {
+		return "", err
+	}
+	// json.Marshal escapes <, > and &, so this is safe to put inside a script element.
+	return fmt.Sprintf(`<script type="application/json" id="%s">%s</script>`, html.EscapeString(scriptID), s), nil
+}
+
+// PropsFromScript reads and decodes the props from the script element with the
+// given ID, see PropsScript.
+func PropsFromScript(scriptID string) (Props, error) {
+	script := js.Global.Get("document").Call("getElementById", scriptID)
+	if script == nil || script == js.Undefined {
+		return nil, fmt.Errorf("Script element %q not found", scriptID)
+	}
+	return UnmarshalProps(script.Get("textContent").String())
+}
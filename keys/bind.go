@@ -0,0 +1,229 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keys
+
+import (
+	"sync"
+
+	"github.com/bep/gr"
+	"github.com/bep/gr/evt"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Binding binds a shortcut to a func. A Binding is a gr.Modifier that scopes the
+// shortcut to the element's subtree, i.e. it fires when a key is pressed while the
+// focus is within the element:
+//
+//	el.Div(
+//		attr.TabIndex(-1),
+//		keys.Bind("mod+s", c.save),
+//		keys.Bind("g i", c.gotoInbox),
+//		...
+//	)
+//
+// Note that the element needs to be focusable, or contain the focused element, to get the
+// key events. As for BindDocument, shortcuts without ctrl, alt or meta do not fire when
+// the focus is in a text field. If bindings in nested elements match the same keys, the
+// innermost wins.
+// Two bindings on the same element that match the same keys are reported to the
+// ConflictHandler. Unlike for BindDocument, this is only detected when a key that
+// both match is pressed, not when the bindings are added.
+//
+// The progress through a sequence is tracked on the element's DOM node, so it
+// survives re-renders.
+//
+// See BindDocument for document wide shortcuts.
+type Binding struct {
+	spec string
+	f    func()
+	m    *matcher
+}
+
+// Bind creates a new Binding of the given shortcut spec, see the package documentation,
+// to the given func. It panics if the spec is invalid.
+func Bind(spec string, f func()) *Binding {
+	seq, err := parse(spec)
+	if err != nil {
+		panic(err)
+	}
+	return &Binding{spec: spec, f: f, m: &matcher{seq: seq}}
+}
+
+// Modify implements the gr.Modifier interface.
+func (b *Binding) Modify(element *gr.Element) {
+	evt.KeyDownK(b.handle).Modify(element)
+}
+
+// The property set on the native event to mark it as handled by a binding.
+const handledKey = "__grKeysHandled"
+
+// The property set on the DOM node to keep the sequence matchers, see matcherFor.
+const matchersKey = "__grKeysMatchers"
+
+// matcherFor returns the matcher for this binding stored on the given DOM node,
+// or the Binding's own if there is no node.
+func (b *Binding) matcherFor(node *js.Object) *matcher {
+	if isNullOrUndefined(node) {
+		return b.m
+	}
+
+	matchers := node.Get(matchersKey)
+	if isNullOrUndefined(matchers) {
+		matchers = js.Global.Get("Object").New()
+		node.Set(matchersKey, matchers)
+	}
+
+	if m := matchers.Get(b.spec); !isNullOrUndefined(m) {
+		return m.Interface().(*matcher)
+	}
+
+	matchers.Set(b.spec, js.MakeWrapper(b.m))
+	return b.m
+}
+
+type handled struct {
+	*js.Object
+	spec   string     `js:"spec"`
+	target *js.Object `js:"target"`
+}
+
+func (b *Binding) handle(e *gr.KeyboardEvent) {
+	if isEditable(e.Object) && !b.m.seq[0].hasCommandModifier() {
+		return
+	}
+
+	if !b.matcherFor(e.CurrentTarget()).feed(e.Object) {
+		return
+	}
+
+	native := e.Get("nativeEvent")
+	if isNullOrUndefined(native) {
+		native = e.Object
+	}
+
+	if h := native.Get(handledKey); !isNullOrUndefined(h) {
+		prev := &handled{Object: h}
+		if prev.target == e.CurrentTarget() {
+			ConflictHandler(&ConflictError{Spec: b.spec, Other: prev.spec})
+		}
+		// Else handled by a binding further down the tree.
+		return
+	}
+
+	h := &handled{Object: js.Global.Get("Object").New()}
+	h.spec = b.spec
+	h.target = e.CurrentTarget()
+	native.Set(handledKey, h)
+
+	e.Call("preventDefault")
+	b.f()
+}
+
+type documentBindings struct {
+	mu       sync.Mutex
+	bindings []*Binding
+	listener *js.Object
+}
+
+var document = &documentBindings{}
+
+// BindDocument binds the given shortcut spec to the given func for the whole document.
+// Shortcuts without ctrl, alt or meta do not fire when the focus is in a text field.
+// Shortcuts handled by a Binding in the focused element's tree do not fire.
+//
+// It returns a func to remove the binding, or an error if the spec is invalid or
+// conflicts with an existing document binding, i.e. one can be triggered by the same
+// key presses as the other, see ConflictError.
+//
+// Document bindings are typically added in ComponentDidMount and removed in
+// ComponentWillUnmount.
+func BindDocument(spec string, f func()) (unbind func(), err error) {
+	seq, err := parse(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Binding{spec: spec, f: f, m: &matcher{seq: seq}}
+
+	document.mu.Lock()
+	defer document.mu.Unlock()
+
+	for _, other := range document.bindings {
+		if seq.conflicts(other.m.seq) {
+			return nil, &ConflictError{Spec: spec, Other: other.spec}
+		}
+	}
+
+	document.bindings = append(document.bindings, b)
+
+	if document.listener == nil {
+		// The same JavaScript func must be passed to removeEventListener.
+		document.listener = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			document.handle(arguments[0])
+			return nil
+		})
+		js.Global.Get("document").Call("addEventListener", "keydown", document.listener)
+	}
+
+	return func() {
+		document.remove(b)
+	}, nil
+}
+
+func (d *documentBindings) remove(b *Binding) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i, other := range d.bindings {
+		if other == b {
+			d.bindings = append(d.bindings[:i], d.bindings[i+1:]...)
+			break
+		}
+	}
+
+	if len(d.bindings) == 0 && d.listener != nil {
+		js.Global.Get("document").Call("removeEventListener", "keydown", d.listener)
+		d.listener = nil
+	}
+}
+
+func (d *documentBindings) handle(event *js.Object) {
+	if !isNullOrUndefined(event.Get(handledKey)) {
+		return
+	}
+
+	d.mu.Lock()
+	bindings := make([]*Binding, len(d.bindings))
+	copy(bindings, d.bindings)
+	d.mu.Unlock()
+
+	editable := isEditable(event)
+
+	for _, b := range bindings {
+		if editable && !b.m.seq[0].hasCommandModifier() {
+			continue
+		}
+		if b.m.feed(event) {
+			event.Call("preventDefault")
+			b.f()
+		}
+	}
+}
+
+func isNullOrUndefined(o *js.Object) bool {
+	return o == nil || o == js.Undefined
+}
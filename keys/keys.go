@@ -0,0 +1,309 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package keys binds keyboard shortcuts, e.g. "mod+s" or the sequence "g i",
+// to funcs.
+//
+// A shortcut spec is one or more chords separated by spaces, where a chord is
+// a key with optional modifiers joined by "+":
+//
+//	ctrl+shift+k
+//	mod+s        mod is meta (⌘) on a Mac, ctrl elsewhere
+//	g i          g followed by i within SequenceTimeout
+//	?            shift is ignored for symbols
+//
+// The modifiers are ctrl (control), alt (option), shift and meta (cmd, command).
+// The keys are the KeyboardEvent key values, case insensitive, e.g. "a", "enter" or
+// "f1", with the aliases esc, return, space, up, down, left, right, del and plus.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/KeyboardEvent/key/Key_Values
+package keys
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// SequenceTimeout is the maximum time allowed between the chords in a sequence.
+var SequenceTimeout = time.Second
+
+// ConflictHandler is called when a conflict between two bindings in the same scope
+// is detected when a key is pressed. The default logs a warning to the console.
+var ConflictHandler = func(err error) {
+	js.Global.Get("console").Call("warn", err.Error())
+}
+
+// ConflictError describes two bindings in the same scope that match the same keys.
+type ConflictError struct {
+	Spec  string
+	Other string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("keys: %q conflicts with %q", e.Spec, e.Other)
+}
+
+var keyAliases = map[string]string{
+	"esc":    "escape",
+	"return": "enter",
+	"space":  " ",
+	"up":     "arrowup",
+	"down":   "arrowdown",
+	"left":   "arrowleft",
+	"right":  "arrowright",
+	"del":    "delete",
+	"plus":   "+",
+}
+
+// The key values of the modifier keys themselves.
+var modifierKeys = map[string]bool{
+	"control": true,
+	"shift":   true,
+	"alt":     true,
+	"meta":    true,
+	"os":      true,
+}
+
+type chord struct {
+	key                    string
+	ctrl, alt, shift, meta bool
+}
+
+func (c chord) String() string {
+	var parts []string
+	if c.ctrl {
+		parts = append(parts, "ctrl")
+	}
+	if c.alt {
+		parts = append(parts, "alt")
+	}
+	if c.shift {
+		parts = append(parts, "shift")
+	}
+	if c.meta {
+		parts = append(parts, "meta")
+	}
+	return strings.Join(append(parts, c.key), "+")
+}
+
+// hasCommandModifier reports whether the chord has a modifier other than shift.
+func (c chord) hasCommandModifier() bool {
+	return c.ctrl || c.alt || c.meta
+}
+
+// ignoresShift reports whether the key is a symbol, e.g. "?", where shift is
+// part of how it is typed on most keyboard layouts.
+func (c chord) ignoresShift() bool {
+	r := []rune(c.key)
+	if len(r) != 1 {
+		return false
+	}
+	return !(r[0] >= 'a' && r[0] <= 'z' || r[0] >= '0' && r[0] <= '9')
+}
+
+func (c chord) matches(event *js.Object) bool {
+	if event.Get("ctrlKey").Bool() != c.ctrl ||
+		event.Get("altKey").Bool() != c.alt ||
+		event.Get("metaKey").Bool() != c.meta {
+		return false
+	}
+
+	if !c.ignoresShift() && event.Get("shiftKey").Bool() != c.shift {
+		return false
+	}
+
+	return eventKey(event) == c.key || codeKey(event) == c.key
+}
+
+type sequence []chord
+
+func (s sequence) String() string {
+	parts := make([]string, len(s))
+	for i, c := range s {
+		parts[i] = c.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+// conflicts reports whether s and other can be triggered by the same key presses,
+// i.e. one is equal to or a prefix of the other.
+func (s sequence) conflicts(other sequence) bool {
+	n := len(s)
+	if len(other) < n {
+		n = len(other)
+	}
+	for i := 0; i < n; i++ {
+		if s[i] != other[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parse parses the given shortcut spec.
+func parse(spec string) (sequence, error) {
+	fields := strings.Fields(strings.ToLower(spec))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("keys: empty spec")
+	}
+
+	seq := make(sequence, len(fields))
+
+	for i, field := range fields {
+		var c chord
+
+		parts := strings.Split(field, "+")
+
+		if field == "+" || strings.HasSuffix(field, "++") {
+			// "+" as the key, e.g. "ctrl++".
+			parts = strings.Split(strings.TrimSuffix(field, "+"), "+")
+			parts[len(parts)-1] = "+"
+		}
+
+		for j, p := range parts {
+			if j == len(parts)-1 {
+				if p == "" {
+					return nil, fmt.Errorf("keys: missing key in %q", spec)
+				}
+				if alias, ok := keyAliases[p]; ok {
+					p = alias
+				}
+				c.key = p
+				break
+			}
+
+			switch p {
+			case "ctrl", "control":
+				c.ctrl = true
+			case "alt", "option":
+				c.alt = true
+			case "shift":
+				c.shift = true
+			case "meta", "cmd", "command":
+				c.meta = true
+			case "mod":
+				if isMac() {
+					c.meta = true
+				} else {
+					c.ctrl = true
+				}
+			default:
+				return nil, fmt.Errorf("keys: unknown modifier %q in %q", p, spec)
+			}
+		}
+
+		seq[i] = c
+	}
+
+	return seq, nil
+}
+
+// matcher tracks the progress through a sequence.
+type matcher struct {
+	seq  sequence
+	pos  int
+	last time.Time
+}
+
+// feed feeds a key down event to the matcher and reports whether it completed
+// the sequence.
+func (m *matcher) feed(event *js.Object) bool {
+	if modifierKeys[eventKey(event)] {
+		return false
+	}
+
+	if m.pos > 0 && time.Since(m.last) > SequenceTimeout {
+		m.pos = 0
+	}
+
+	if !m.seq[m.pos].matches(event) {
+		m.pos = 0
+		if !m.seq[0].matches(event) {
+			return false
+		}
+	}
+
+	m.pos++
+	m.last = time.Now()
+
+	if m.pos == len(m.seq) {
+		m.pos = 0
+		return true
+	}
+
+	return false
+}
+
+func eventKey(event *js.Object) string {
+	key := event.Get("key")
+	if isNullOrUndefined(key) {
+		return ""
+	}
+	return strings.ToLower(key.String())
+}
+
+// codeKey returns the key from the physical key code for letters and digits,
+// e.g. "s" for "KeyS", so shortcuts also work when alt changes the key value.
+func codeKey(event *js.Object) string {
+	code := event.Get("code")
+	if isNullOrUndefined(code) {
+		return ""
+	}
+	s := code.String()
+	switch {
+	case strings.HasPrefix(s, "Key"):
+		return strings.ToLower(strings.TrimPrefix(s, "Key"))
+	case strings.HasPrefix(s, "Digit"):
+		return strings.TrimPrefix(s, "Digit")
+	}
+	return ""
+}
+
+func isMac() bool {
+	navigator := js.Global.Get("navigator")
+	if navigator == js.Undefined {
+		return false
+	}
+	platform := navigator.Get("platform")
+	if platform == js.Undefined {
+		return false
+	}
+	p := platform.String()
+	return strings.Contains(p, "Mac") || strings.Contains(p, "iPhone") || strings.Contains(p, "iPad")
+}
+
+// isEditable reports whether the event target is a text field or similar.
+func isEditable(event *js.Object) bool {
+	target := event.Get("target")
+	if isNullOrUndefined(target) {
+		return false
+	}
+	if target.Get("isContentEditable").Bool() {
+		return true
+	}
+	tag := target.Get("tagName")
+	if isNullOrUndefined(tag) {
+		return false
+	}
+	switch strings.ToUpper(tag.String()) {
+	case "INPUT", "TEXTAREA", "SELECT":
+		return true
+	}
+	return false
+}
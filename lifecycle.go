@@ -52,6 +52,25 @@ type StateInitializer interface {
 	GetInitialState() State
 }
 
+// DefaultPropsProvider provides default values for props not set by the parent component.
+// The defaults are merged into the props by React, so This.Props and the props received
+// in the other lifecycle methods will have them set.
+//
+// GetDefaultProps is invoked once and cached when the component is created, with no this context set,
+// so the values should not depend on the component instance.
+type DefaultPropsProvider interface {
+	GetDefaultProps() Props
+}
+
+// PropTypesProvider provides a template, a Go struct or a map, used to derive
+// the PropTypes used to validate the props passed to the component.
+// See WithPropTypes for a description of the template.
+//
+// PropTypes is called once when the component is created, with no this context set.
+type PropTypesProvider interface {
+	PropTypes() interface{}
+}
+
 // ChildContextProvider provides the context for the children.
 //
 // The GetChildContext function will be called when the state or props changes.
@@ -106,3 +125,25 @@ type ComponentWillUnmount interface {
 type ComponentDidMount interface {
 	ComponentDidMount()
 }
+
+// ComponentDidCatch gets invoked when an error is thrown in a descendant component,
+// making this component an error boundary. Go panics in Render and in event listeners
+// are converted to errors that can be caught here.
+// Note that this requires React 16 or newer.
+//
+// See https://reactjs.org/docs/error-boundaries.html
+type ComponentDidCatch interface {
+	ComponentDidCatch(err error, info ErrorInfo)
+}
+
+// ErrorBoundary gets invoked when an error is thrown in a descendant component,
+// and returns the state to set to render a fallback UI.
+// Note that this requires React 16 or newer.
+//
+// GetDerivedStateFromError maps to the static getDerivedStateFromError in React,
+// so no this context is set.
+//
+// See https://reactjs.org/docs/error-boundaries.html
+type ErrorBoundary interface {
+	GetDerivedStateFromError(err error) State
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Map is a Modifier that renders a keyed list of children, one for each of the
+// items in the given slice or array:
+//
+//	el.UnorderedList(
+//		gr.Map(todos,
+//			func(i int) string { return todos[i].ID },
+//			func(i int) gr.Component { return el.ListItem(gr.Text(todos[i].Text)) },
+//		),
+//	)
+//
+// The key should identify the item, e.g. a database ID, and not its position in the list,
+// so React can keep the state of the list items when items are added, removed or reordered.
+// The keys must be unique within the list; duplicates are logged to the console as errors.
+// Items where render returns nil are skipped.
+//
+// The list items are excluded from the automatic key generation, see Dynamic.
+//
+// See https://reactjs.org/docs/lists-and-keys.html
+func Map(items interface{}, key func(i int) string, render func(i int) Component) Modifier {
+	v := reflect.ValueOf(items)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		panic(fmt.Sprintf("Map needs a slice or an array, got %T", items))
+	}
+
+	list := make(keyedList, 0, v.Len())
+	seen := make(map[string]int)
+
+	for i := 0; i < v.Len(); i++ {
+		c := render(i)
+		if c == nil {
+			continue
+		}
+		k := key(i)
+		if j, found := seen[k]; found {
+			js.Global.Get("console").Call("error",
+				fmt.Sprintf("gr: Duplicate key %q in Map: items %d and %d", k, j, i))
+		} else {
+			seen[k] = i
+		}
+		list = append(list, keyedElement(k, c))
+	}
+
+	return list
+}
+
+type keyedList []*Element
+
+// Modify implements the Modifier interface.
+func (l keyedList) Modify(in *Element) {
+	for _, e := range l {
+		in.children = append(in.children, e)
+	}
+}
+
+func keyedElement(key string, c Component) *Element {
+	e := CreateIfNeeded(c)
+
+	if e.element == nil {
+		if e.properties == nil {
+			e.properties = make(map[string]interface{})
+		}
+		e.properties["key"] = key
+		e.keyed = true
+		return e
+	}
+
+	// A ready-to-use React element; it needs to be cloned to get the key.
+	if !react.Call("isValidElement", e.element).Bool() {
+		panic(fmt.Sprintf("Map render func must return an element, got %T", c))
+	}
+
+	return NewPreparedElement(react.Call("cloneElement", e.element, js.M{"key": key}))
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// ListenWindow adds a listener for the event with the given name, e.g. "resize" or "popstate",
+// on the browser window. The listener is removed when the component is unmounted,
+// or when the returned func is invoked.
+//
+// The name can also be given as the React property name used by the evt package,
+// e.g. "onResize" or "onDoubleClick" (for "dblclick"); it panics if there is no such
+// event. A typical place to call this is ComponentDidMount:
+//
+//	func (c *myComponent) ComponentDidMount() {
+//		c.ListenWindow("resize", c.onResize)
+//	}
+func (t *This) ListenWindow(name string, l Listener) (remove func()) {
+	return t.listen(js.Global, name, l)
+}
+
+// ListenDocument adds a listener for the event with the given name, e.g. "visibilitychange",
+// on the document. See ListenWindow.
+func (t *This) ListenDocument(name string, l Listener) (remove func()) {
+	return t.listen(js.Global.Get("document"), name, l)
+}
+
+func (t *This) listen(target *js.Object, name string, l Listener) func() {
+	if isUnmounted(t.This) {
+		return func() {}
+	}
+
+	name = domEventName(name)
+
+	// The This may be reused by the caller, e.g. the one embedded in the component.
+	that := NewThis(t.This)
+
+	// The same JavaScript func must be passed to removeEventListener.
+	f := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		defer func() {
+			if r := recover(); r != nil {
+				throwInRender(that, toJSError(r, name))
+			}
+		}()
+		l(&Event{Object: arguments[0], This: that})
+		return nil
+	})
+
+	target.Call("addEventListener", name, f)
+
+	removed := false
+	remove := func() {
+		if removed {
+			return
+		}
+		removed = true
+		target.Call("removeEventListener", name, f)
+	}
+
+	onUnmount(that.This, remove)
+
+	return remove
+}
+
+// domEventName returns the DOM event name for the given name, e.g. "dblclick"
+// for "onDoubleClick". Names not starting with "on" are returned as is, so custom
+// events work. It panics if the name is an unknown React property name.
+func domEventName(name string) string {
+	if len(name) > 2 && strings.HasPrefix(name, "on") && strings.ToUpper(name[2:3]) == name[2:3] {
+		n, ok := domEventNames[name]
+		if !ok {
+			panic(fmt.Sprintf("Unknown event %q", name))
+		}
+		return n
+	}
+	return name
+}
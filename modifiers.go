@@ -38,7 +38,9 @@ func (s *textEl) Modify(in *Element) {
 
 // Node implements the component interface.
 func (s *textEl) Node() *js.Object {
-	return js.Global.Get("Object").New(s.text)
+	// This must be a primitive string and not a String object; React >= 16
+	// treats the latter as an iterable of characters.
+	return js.Global.Get("String").Invoke(s.text)
 }
 
 type cssClasses []string
@@ -0,0 +1,184 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// PropTypes are derived from either a Go struct or a template map.
+//
+// For structs, the prop names are taken from the gr struct tags (see PropsFrom),
+// and a prop is marked as required with the required option:
+//
+//    type buttonProps struct {
+//        Text    string        `gr:"text,required"`
+//        Count   int           `gr:"count"`
+//        OnClick func()        `gr:"onClick"`
+//        Icon    *gr.Element   `gr:"icon"`
+//        Items   []item        `gr:"items"`
+//    }
+//
+// The Go types map to these PropTypes:
+//
+//    bool                      bool
+//    ints and floats           number
+//    string                    string
+//    func                      func
+//    slices and arrays         arrayOf
+//    map[string]T              objectOf
+//    struct                    shape
+//    time.Time                 instanceOf(Date)
+//    *Element                  element
+//    Component                 node
+//    anything else             any
+//
+// For template maps the types are inferred from the values, and all props are optional.
+//
+// See https://facebook.github.io/react/docs/typechecking-with-proptypes.html
+
+var (
+	elementType   = reflect.TypeOf((*Element)(nil))
+	componentType = reflect.TypeOf((*Component)(nil)).Elem()
+)
+
+// WithPropTypes adds PropTypes validation derived from the given template,
+// either a Go struct or a map, to the component.
+// This will take precedence over a PropTypesProvider implementation.
+func WithPropTypes(template interface{}) Option {
+	// This needs to run before createClass
+	return Option{preparePhase: true, action: func(r *ReactComponent) error {
+		propTypes, err := propTypesFromTemplate(template)
+		if err != nil {
+			return err
+		}
+		r.reactClass.propTypes = propTypes
+		return nil
+	}}
+}
+
+func propTypesFromTemplate(template interface{}) (js.M, error) {
+	if m, ok := template.(Props); ok {
+		template = map[string]interface{}(m)
+	}
+
+	if m, ok := template.(map[string]interface{}); ok {
+		return extractPropTypesFromTemplate(m), nil
+	}
+
+	rt := reflect.TypeOf(template)
+
+	if rt != nil && rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	if rt == nil || rt.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("PropTypes template must be a struct or a map, got %T", template)
+	}
+
+	return structPropTypes(rt), nil
+}
+
+func structPropTypes(rt reflect.Type) js.M {
+	return structPropTypesSeen(rt, make(map[reflect.Type]bool))
+}
+
+func structPropTypesSeen(rt reflect.Type, seen map[reflect.Type]bool) js.M {
+	propTypes := js.M{}
+
+	seen[rt] = true
+	defer delete(seen, rt)
+
+	for _, f := range structFields(rt) {
+		pt := typeToPropType(rt.Field(f.index).Type, seen)
+		if f.required {
+			pt = pt.Get("isRequired")
+		}
+		propTypes[f.name] = pt
+	}
+
+	return propTypes
+}
+
+func typeToPropType(t reflect.Type, seen map[reflect.Type]bool) *js.Object {
+	switch {
+	case t == elementType:
+		return propType("element")
+	case t == jsObjectType:
+		return propType("any")
+	case t == timeType:
+		return propType("instanceOf").Invoke(js.Global.Get("Date"))
+	case t == componentType || (t.Kind() != reflect.Interface && t.Implements(componentType)):
+		return propType("node")
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return propType("bool")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return propType("number")
+	case reflect.String:
+		return propType("string")
+	case reflect.Func:
+		return propType("func")
+	case reflect.Ptr:
+		return typeToPropType(t.Elem(), seen)
+	case reflect.Slice, reflect.Array:
+		return propType("arrayOf").Invoke(typeToPropType(t.Elem(), seen))
+	case reflect.Map:
+		if t.Key().Kind() == reflect.String {
+			return propType("objectOf").Invoke(typeToPropType(t.Elem(), seen))
+		}
+		return propType("object")
+	case reflect.Struct:
+		if seen[t] {
+			// Recursive type.
+			return propType("object")
+		}
+		return propType("shape").Invoke(structPropTypesSeen(t, seen))
+	}
+
+	return propType("any")
+}
+
+func valueToPropType(v interface{}) *js.Object {
+	switch v.(type) {
+	case nil, *js.Object:
+		return propType("any")
+	case map[string]interface{}, Props, State, Context:
+		return propType("object")
+	case []interface{}:
+		return propType("array")
+	}
+
+	return typeToPropType(reflect.TypeOf(v), make(map[reflect.Type]bool))
+}
+
+func extractPropTypesFromTemplate(t map[string]interface{}) js.M {
+	propTypes := js.M{}
+
+	for k, v := range t {
+		propTypes[k] = valueToPropType(v)
+	}
+
+	return propTypes
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"errors"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type compareMode int
+
+const (
+	compareNone compareMode = iota
+	compareShallow
+	compareDeep
+)
+
+// Pure is an option that makes the component skip rendering when neither its props
+// nor its state have changed, comparing all the values by reference. This is the same as
+// React's PureComponent, and saves writing ShouldComponentUpdate by hand.
+//
+// Note that this only works if the props and state are replaced and not mutated, and that
+// new funcs and elements are always considered changed. A component that implements
+// ShouldComponentUpdate cannot be Pure.
+// For function components, use Memo.
+//
+// See https://reactjs.org/docs/react-api.html#reactpurecomponent
+func Pure() Option {
+	// This needs to run before createClass
+	return Option{preparePhase: true, action: func(r *ReactComponent) error {
+		if r.funcComponent && !r.memo {
+			return errors.New("Pure does not apply to function components, use Memo")
+		}
+		if r.compare == compareNone {
+			r.compare = compareShallow
+		}
+		return nil
+	}}
+}
+
+// DeepCompare is an option that works as Pure, but compares the props and state
+// using reflect.DeepEqual, see HasChangedDeeply. This is more expensive, so only use it
+// if the props and state are values that are recreated on every render.
+// Funcs and React elements are still compared by reference.
+// For function components, use it with Memo.
+func DeepCompare() Option {
+	// This needs to run before createClass
+	return Option{preparePhase: true, action: func(r *ReactComponent) error {
+		if r.funcComponent && !r.memo {
+			return errors.New("DeepCompare does not apply to function components, use it with Memo")
+		}
+		r.compare = compareDeep
+		return nil
+	}}
+}
+
+// Memo creates a function component, see NewFunc, that skips rendering when its
+// props have not changed, comparing them by reference. With the DeepCompare option
+// the props are compared deeply. Memo requires React 16.6 or newer.
+//
+// See https://reactjs.org/docs/react-api.html#reactmemo
+func Memo(f RenderFunc, options ...Option) *ReactComponent {
+	memo := react.Get("memo")
+	if memo == js.Undefined {
+		panic("Memo requires React 16.6 or newer")
+	}
+
+	var root *ReactComponent
+
+	areEqual := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		return !hasChangedAll(arguments[0], arguments[1], root.compare)
+	})
+
+	root = newFuncComponent(func(arguments []*js.Object) Component {
+		var props *js.Object
+		if len(arguments) > 0 {
+			props = arguments[0]
+		}
+		return f(objectToMap(props), childrenFromProps(props))
+	}, func(fn *js.Object) *js.Object {
+		return memo.Invoke(fn, areEqual)
+	}, append([]Option{memoOption}, options...))
+
+	if root.compare == compareNone {
+		root.compare = compareShallow
+	}
+
+	return root
+}
+
+// memoOption marks the component as memoized, so Pure and DeepCompare apply.
+var memoOption = Option{preparePhase: true, action: func(r *ReactComponent) error {
+	r.memo = true
+	return nil
+}}
+
+func makePureUpdateFunc(mode compareMode) *js.Object {
+	return js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		var nextProps, nextState *js.Object
+		if len(arguments) > 0 {
+			nextProps = arguments[0]
+		}
+		if len(arguments) > 1 {
+			nextState = arguments[1]
+		}
+		return hasChangedAll(this.Get("props"), nextProps, mode) ||
+			hasChangedAll(this.Get("state"), nextState, mode)
+	})
+}
+
+// hasChangedAll reports whether any of the values in the two JavaScript objects differ.
+func hasChangedAll(o1, o2 *js.Object, mode compareMode) bool {
+	m1, m2 := objectToMap(o1), objectToMap(o2)
+
+	if len(m1) != len(m2) {
+		return true
+	}
+
+	keys := make([]string, 0, len(m1))
+	for k := range m1 {
+		if _, ok := m2[k]; !ok {
+			return true
+		}
+		keys = append(keys, k)
+	}
+
+	if mode != compareDeep {
+		return hasChanged(m1, m2, keys...)
+	}
+
+	for _, k := range keys {
+		m1[k], m2[k] = toComparable(m1[k].(*js.Object)), toComparable(m2[k].(*js.Object))
+	}
+
+	return hasChangedDeeply(m1, m2, keys...)
+}
+
+// toComparable converts the JavaScript value to a Go value suitable for reflect.DeepEqual.
+// Funcs and React elements, which may be cyclic, are kept as-is and compared by reference.
+func toComparable(o *js.Object) interface{} {
+	if isNullOrUndefined(o) || o.Get("$$typeof") != js.Undefined {
+		return o
+	}
+	if js.Global.Get("Object").Get("prototype").Get("toString").Call("call", o).String() == "[object Function]" {
+		return o
+	}
+	return o.Interface()
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/bep/gr/support"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// This file contains the version detection layer that makes the same
+// Go API work against React 15, 16, 17 and 18.
+//
+// React.createClass and React.PropTypes were moved out of React core in 15.5
+// and removed in 16.0. React.createFactory was deprecated in 16.13.
+// For those we look for the standalone packages, first as globals and then via require().
+
+type reactVersion struct {
+	major, minor, patch int
+}
+
+var (
+	version reactVersion
+
+	// The createClass implementation in use; React.createClass or create-react-class.
+	reactCreateClass *js.Object
+
+	// The PropTypes in use; React.PropTypes or prop-types.
+	reactPropTypes *js.Object
+
+	// react-dom/client, React >= 18 only. Loaded on demand.
+	reactDOMClient *js.Object
+)
+
+// Lifecycle methods that got an UNSAFE_ prefix in React 16.3.
+var unsafeLifecycles = []string{
+	"componentWillMount",
+	"componentWillReceiveProps",
+	"componentWillUpdate",
+}
+
+func initReactVersion() {
+	if v := react.Get("version"); v != js.Undefined {
+		version = parseReactVersion(v.String())
+	}
+
+	if f := react.Get("createClass"); f != js.Undefined {
+		reactCreateClass = f
+	} else {
+		reactCreateClass = lookupModule("createReactClass", "create-react-class")
+	}
+
+	if p := react.Get("PropTypes"); p != js.Undefined {
+		reactPropTypes = p
+	} else {
+		reactPropTypes = lookupModule("PropTypes", "prop-types")
+	}
+}
+
+// ReactVersion returns the version of the Facebook React library in use, e.g. "16.2.0".
+func ReactVersion() string {
+	return react.Get("version").String()
+}
+
+// parseReactVersion parses a version string, e.g. "16.2.0". Any parts that
+// cannot be parsed are left as 0.
+func parseReactVersion(s string) reactVersion {
+	var v reactVersion
+
+	parts := strings.SplitN(s, ".", 3)
+	nums := []*int{&v.major, &v.minor, &v.patch}
+
+	for i, p := range parts {
+		// Strip any pre-release suffix, e.g. "0-rc.1".
+		if idx := strings.IndexAny(p, "-+"); idx != -1 {
+			p = p[:idx]
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			break
+		}
+		*nums[i] = n
+	}
+
+	return v
+}
+
+func (v reactVersion) atLeast(major, minor int) bool {
+	if v.major != major {
+		return v.major > major
+	}
+	return v.minor >= minor
+}
+
+// lookupModule looks for a JS module as a global first, then via require.
+// It returns nil if not found.
+func lookupModule(global, module string) *js.Object {
+	if g := js.Global.Get(global); g != js.Undefined {
+		return g
+	}
+	m, err := support.Require(module)
+	if err != nil {
+		return nil
+	}
+	return m
+}
+
+// createClass creates a React class from the given spec.
+func createClass(spec *js.Object) *js.Object {
+	if reactCreateClass == nil {
+		panic("Cannot find createClass: React >= 16 needs create-react-class, either as the global createReactClass or via require")
+	}
+
+	if version.atLeast(16, 3) {
+		for _, name := range unsafeLifecycles {
+			if f := spec.Get(name); f != js.Undefined && f != nil {
+				spec.Set("UNSAFE_"+name, f)
+				spec.Delete(name)
+			}
+		}
+	}
+
+	return reactCreateClass.Invoke(spec)
+}
+
+// createFactory returns a function that creates elements of the given class.
+func createFactory(class *js.Object) *js.Object {
+	if !version.atLeast(16, 13) {
+		if f := react.Get("createFactory"); f != js.Undefined {
+			return react.Call("createFactory", class)
+		}
+	}
+
+	factory := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		args := []interface{}{class}
+		for _, a := range arguments {
+			args = append(args, a)
+		}
+		return react.Call("createElement", args...)
+	})
+
+	// Mirror what React's own factory does.
+	factory.Set("type", class)
+
+	return factory
+}
+
+// propType returns the PropTypes validator with the given name, e.g. "string".
+func propType(name string) *js.Object {
+	if reactPropTypes == nil {
+		panic("Cannot find PropTypes: React >= 16 needs prop-types, either as the global PropTypes or via require")
+	}
+	return reactPropTypes.Get(name)
+}
+
+// createRoot creates a React 18 root for the given container, or returns nil
+// if not supported by the React version in use.
+func createRoot(container *js.Object) *js.Object {
+	if !version.atLeast(18, 0) {
+		return nil
+	}
+
+	if reactDOMClient == nil {
+		// ReactDOM.createRoot works, but warns about not using react-dom/client.
+		reactDOMClient = lookupModule("ReactDOMClient", "react-dom/client")
+		if reactDOMClient == nil {
+			reactDOMClient = reactDOM
+		}
+	}
+
+	if reactDOMClient.Get("createRoot") == js.Undefined {
+		return nil
+	}
+
+	return reactDOMClient.Call("createRoot", container)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"testing"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+func TestParseReactVersion(t *testing.T) {
+	for i, test := range []struct {
+		in     string
+		expect reactVersion
+	}{
+		{"15.4.2", reactVersion{15, 4, 2}},
+		{"16.14.0", reactVersion{16, 14, 0}},
+		{"18.0.0-rc.1", reactVersion{18, 0, 0}},
+		{"16.3.0+build.1", reactVersion{16, 3, 0}},
+		{"17.0", reactVersion{17, 0, 0}},
+		{"16.x.1", reactVersion{16, 0, 0}},
+		{"", reactVersion{}},
+	} {
+		if got := parseReactVersion(test.in); got != test.expect {
+			t.Errorf("[%d] parseReactVersion(%q): got %v, expected %v", i, test.in, got, test.expect)
+		}
+	}
+}
+
+func TestReactVersionAtLeast(t *testing.T) {
+	v := reactVersion{16, 3, 1}
+
+	for i, test := range []struct {
+		major, minor int
+		expect       bool
+	}{
+		{15, 6, true},
+		{16, 0, true},
+		{16, 3, true},
+		{16, 4, false},
+		{17, 0, false},
+	} {
+		if got := v.atLeast(test.major, test.minor); got != test.expect {
+			t.Errorf("[%d] atLeast(%d, %d): got %t", i, test.major, test.minor, got)
+		}
+	}
+
+	if ReactVersion() == "" || version.major < 15 {
+		t.Errorf("Unexpected React version %q, parsed as %v", ReactVersion(), version)
+	}
+}
+
+func withReactVersion(v reactVersion, f func()) {
+	prev := version
+	defer func() { version = prev }()
+	version = v
+	f()
+}
+
+func TestCreateClassUnsafeLifecycles(t *testing.T) {
+	newSpec := func() *js.Object {
+		spec := js.Global.Get("Object").New()
+		spec.Set("render", func() *js.Object { return nil })
+		spec.Set("componentWillMount", func() {})
+		spec.Set("componentWillReceiveProps", func() {})
+		spec.Set("componentWillUpdate", func() {})
+		spec.Set("componentDidMount", func() {})
+		return spec
+	}
+
+	withReactVersion(reactVersion{16, 3, 0}, func() {
+		proto := createClass(newSpec()).Get("prototype")
+		for _, name := range unsafeLifecycles {
+			if proto.Get(name) != js.Undefined {
+				t.Errorf("%s should have been renamed", name)
+			}
+			if proto.Get("UNSAFE_"+name) == js.Undefined {
+				t.Errorf("UNSAFE_%s not set", name)
+			}
+		}
+		if proto.Get("componentDidMount") == js.Undefined {
+			t.Error("componentDidMount should be kept")
+		}
+	})
+
+	withReactVersion(reactVersion{15, 6, 0}, func() {
+		proto := createClass(newSpec()).Get("prototype")
+		for _, name := range unsafeLifecycles {
+			if proto.Get(name) == js.Undefined {
+				t.Errorf("%s should be kept", name)
+			}
+		}
+	})
+}
+
+func TestCreateFactoryFallback(t *testing.T) {
+	spec := js.Global.Get("Object").New()
+	spec.Set("render", func() *js.Object { return nil })
+	class := createClass(spec)
+
+	withReactVersion(reactVersion{16, 13, 0}, func() {
+		factory := createFactory(class)
+		if factory.Get("type") != class {
+			t.Error("factory type not set")
+		}
+
+		elem := factory.Invoke(js.M{"title": "t"})
+		if elem.Get("type") != class {
+			t.Error("wrong element type")
+		}
+		if elem.Get("props").Get("title").String() != "t" {
+			t.Error("props not passed")
+		}
+	})
+}
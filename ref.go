@@ -0,0 +1,130 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Ref holds a reference to a DOM element or a mounted class component, set by React
+// when the Ref is passed to attr.Ref:
+//
+//	input := gr.NewRef()
+//	...
+//	el.Input(attr.Ref(input))
+//	...
+//	input.DOM().Call("focus")
+//
+// This replaces the string refs, see Refs, which are deprecated in React.
+//
+// See https://reactjs.org/docs/refs-and-the-dom.html
+type Ref struct {
+	*js.Object
+}
+
+// NewRef creates a new Ref.
+func NewRef() *Ref {
+	if f := react.Get("createRef"); f != js.Undefined {
+		return &Ref{Object: f.Invoke()}
+	}
+
+	// Object refs arrived in React 16.3; use a callback ref for older versions.
+	return NewCallbackRef(nil)
+}
+
+// NewCallbackRef creates a new Ref that invokes the given func, if set, whenever
+// React sets the reference, with nil when the element is unmounted.
+func NewCallbackRef(f func(current *js.Object)) *Ref {
+	ref := &Ref{}
+
+	ref.Object = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		var current *js.Object
+		if len(arguments) > 0 && !isNullOrUndefined(arguments[0]) {
+			current = arguments[0]
+		}
+
+		ref.Set("current", current)
+
+		if f != nil {
+			f(current)
+		}
+
+		return nil
+	})
+
+	ref.Set("current", nil)
+
+	return ref
+}
+
+// Current returns the current reference, or nil if not set. This is a DOM element
+// for HTML elements, and the component's JavaScript this for class components.
+func (r *Ref) Current() *js.Object {
+	current := r.Get("current")
+	if isNullOrUndefined(current) {
+		return nil
+	}
+	return current
+}
+
+// DOM returns the current reference if it is a DOM element, else nil.
+func (r *Ref) DOM() *js.Object {
+	current := r.Current()
+	if current == nil || current.Get("nodeType") == js.Undefined {
+		return nil
+	}
+	return current
+}
+
+// ForwardRefFunc is the signature of the render func of a component created
+// with NewForwardRef. The ref is nil if not set by the parent.
+type ForwardRefFunc func(props Props, children *Children, ref *Ref) Component
+
+// NewForwardRef creates a new function component that passes on the ref set by its
+// parent to one of its children, e.g. to let the parent focus an input:
+//
+//	fancyInput := gr.NewForwardRef(func(props gr.Props, children *gr.Children, ref *gr.Ref) gr.Component {
+//		return el.Input(attr.ClassName("fancy"), attr.Ref(ref))
+//	})
+//	...
+//	fancyInput.CreateElement(gr.Props{"ref": input})
+//
+// See NewFunc for the options, and https://reactjs.org/docs/forwarding-refs.html
+// This requires React 16.3 or newer.
+func NewForwardRef(f ForwardRefFunc, options ...Option) *ReactComponent {
+	forwardRef := react.Get("forwardRef")
+	if forwardRef == js.Undefined {
+		panic("NewForwardRef requires React 16.3 or newer")
+	}
+
+	return newFuncComponent(func(arguments []*js.Object) Component {
+		var props *js.Object
+		var ref *Ref
+
+		if len(arguments) > 0 {
+			props = arguments[0]
+		}
+
+		if len(arguments) > 1 && !isNullOrUndefined(arguments[1]) {
+			ref = &Ref{Object: arguments[1]}
+		}
+
+		return f(objectToMap(props), childrenFromProps(props), ref)
+	}, func(fn *js.Object) *js.Object {
+		return forwardRef.Invoke(fn)
+	}, options)
+}
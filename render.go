@@ -17,17 +17,27 @@ limitations under the License.
 package gr
 
 import (
+	"sync"
 	"time"
-	//"github.com/gopherjs/gopherjs/js"
+
+	"github.com/gopherjs/gopherjs/js"
 )
 
 const (
 	defaultFramesPerSecond = 3 // 24
 	defaultWaitTime        = 1000 / defaultFramesPerSecond
+
+	// Used when requestAnimationFrame isn't available, e.g. in Node.js.
+	defaultFallbackFrameInterval = time.Second / 60
 )
 
 // RenderLoop runs the given render func in a loop at the given interval.
 // It can be stopped by closing the returned channel.
+//
+// The loop is driven by a Scheduler, so the renders are aligned with the animation
+// frames, and Invalidate will trigger a render before the next tick.
+// Note that this renders on every tick whether something has changed or not;
+// use a Scheduler directly to only render when invalidated.
 func RenderLoop(render func(), interval ...time.Duration) chan struct{} {
 
 	renderInterval := defaultWaitTime * time.Millisecond
@@ -36,16 +46,21 @@ func RenderLoop(render func(), interval ...time.Duration) chan struct{} {
 		renderInterval = interval[0]
 	}
 
+	// Not dirty, so the first render is on the first tick.
+	s := &Scheduler{render: render, FallbackInterval: defaultFallbackFrameInterval}
+	s.Start()
+
 	quit := make(chan struct{})
 
 	go func() {
+		ticker := time.NewTicker(renderInterval)
+		defer ticker.Stop()
 		for {
-			// TODO(bep): Use this in browsers that supports it.
-			//ran := js.Global.Get("requestAnimationFrame")
 			select {
-			case <-time.After(renderInterval):
-				render()
+			case <-ticker.C:
+				s.Invalidate()
 			case <-quit:
+				s.Stop()
 				return
 			}
 		}
@@ -53,3 +68,177 @@ func RenderLoop(render func(), interval ...time.Duration) chan struct{} {
 
 	return quit
 }
+
+var (
+	schedulersMu sync.Mutex
+	schedulers   = make(map[*Scheduler]bool)
+)
+
+// Invalidate marks all running schedulers as dirty, so they will render on the next frame.
+func Invalidate() {
+	schedulersMu.Lock()
+	defer schedulersMu.Unlock()
+
+	for s := range schedulers {
+		s.Invalidate()
+	}
+}
+
+// FrameStats holds frame timing statistics for a Scheduler.
+type FrameStats struct {
+	// Rendered is the number of frames rendered.
+	Rendered int
+
+	// Skipped is the number of frames skipped because nothing had changed.
+	Skipped int
+
+	// Last is the duration of the last render.
+	Last time.Duration
+
+	// Max is the duration of the slowest render.
+	Max time.Duration
+
+	// Total is the total time spent rendering.
+	Total time.Duration
+}
+
+// Average returns the average render duration.
+func (f FrameStats) Average() time.Duration {
+	if f.Rendered == 0 {
+		return 0
+	}
+	return f.Total / time.Duration(f.Rendered)
+}
+
+// Scheduler runs a render func once per animation frame, but only when something
+// has changed, i.e. when Invalidate has been called since the last render.
+//
+// It uses requestAnimationFrame when present, with a timer as fallback (e.g. in Node.js).
+// The render func runs in its own goroutine, so it may block.
+type Scheduler struct {
+	render func()
+
+	// FallbackInterval is the frame interval used when requestAnimationFrame is not
+	// available. Default is 60 frames per second.
+	FallbackInterval time.Duration
+
+	mu      sync.Mutex
+	dirty   bool
+	running bool
+	stats   FrameStats
+
+	// Incremented on every Start, so frames requested before a Stop
+	// do not keep running after the next Start.
+	generation int
+}
+
+// NewScheduler creates a new Scheduler for the given render func.
+// The scheduler starts dirty, so the first frame will render.
+func NewScheduler(render func()) *Scheduler {
+	return &Scheduler{render: render, dirty: true, FallbackInterval: defaultFallbackFrameInterval}
+}
+
+// Start starts the scheduler. It can be stopped with Stop.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	if s.running {
+		s.mu.Unlock()
+		return
+	}
+	s.running = true
+	s.generation++
+	generation := s.generation
+	s.mu.Unlock()
+
+	schedulersMu.Lock()
+	schedulers[s] = true
+	schedulersMu.Unlock()
+
+	s.requestFrame(generation)
+}
+
+// Stop stops the scheduler.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	s.running = false
+	s.mu.Unlock()
+
+	schedulersMu.Lock()
+	delete(schedulers, s)
+	schedulersMu.Unlock()
+}
+
+// Invalidate marks the scheduler as dirty, so it will render on the next frame.
+func (s *Scheduler) Invalidate() {
+	s.mu.Lock()
+	s.dirty = true
+	s.mu.Unlock()
+}
+
+// Stats returns the frame timing statistics.
+func (s *Scheduler) Stats() FrameStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+func (s *Scheduler) requestFrame(generation int) {
+	frame := func() {
+		if !s.isCurrent(generation) {
+			return
+		}
+		// Blocking is not allowed in callbacks from JS, so render in a goroutine.
+		go s.frame(generation)
+	}
+
+	if raf := js.Global.Get("requestAnimationFrame"); raf != js.Undefined {
+		raf.Invoke(frame)
+		return
+	}
+
+	interval := s.FallbackInterval
+	if interval <= 0 {
+		interval = defaultFallbackFrameInterval
+	}
+
+	js.Global.Call("setTimeout", frame, interval.Seconds()*1000)
+}
+
+// isCurrent reports whether the frame loop started in the given generation should
+// keep running.
+func (s *Scheduler) isCurrent(generation int) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.running && s.generation == generation
+}
+
+func (s *Scheduler) frame(generation int) {
+	s.mu.Lock()
+	if !s.running || s.generation != generation {
+		s.mu.Unlock()
+		return
+	}
+	dirty := s.dirty
+	s.dirty = false
+	if !dirty {
+		s.stats.Skipped++
+	}
+	s.mu.Unlock()
+
+	if dirty {
+		start := time.Now()
+		s.render()
+		d := time.Since(start)
+
+		s.mu.Lock()
+		s.stats.Rendered++
+		s.stats.Last = d
+		s.stats.Total += d
+		if d > s.stats.Max {
+			s.stats.Max = d
+		}
+		s.mu.Unlock()
+	}
+
+	s.requestFrame(generation)
+}
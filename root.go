@@ -0,0 +1,176 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var (
+	rootsMu sync.Mutex
+	roots   []*Root
+)
+
+// Root represents a DOM container with a React tree rendered into it.
+// An application can have many independent roots, e.g. widgets embedded in a
+// legacy page; see Roots and UnmountAll.
+//
+// With React 18 this uses ReactDOM.createRoot, for older versions ReactDOM.render.
+type Root struct {
+	container *js.Object
+
+	// The React 18 root, nil for older versions.
+	reactRoot *js.Object
+
+	component Component
+	props     Props
+}
+
+// NewRoot creates a new Root for the given DOM container and registers it.
+// If a Root already exists for the container, that Root is returned.
+func NewRoot(container *js.Object) *Root {
+	if isNullOrUndefined(container) {
+		panic("Root container must be set")
+	}
+
+	rootsMu.Lock()
+	defer rootsMu.Unlock()
+
+	for _, r := range roots {
+		if r.container == container {
+			return r
+		}
+	}
+
+	r := &Root{container: container, reactRoot: createRoot(container)}
+	roots = append(roots, r)
+
+	return r
+}
+
+// NewRootFromID creates a new Root for the DOM element with the given ID, see NewRoot.
+func NewRootFromID(elementID string) *Root {
+	container := js.Global.Get("document").Call("getElementById", elementID)
+	if isNullOrUndefined(container) {
+		panic(fmt.Sprintf("Element with ID %q not found", elementID))
+	}
+	return NewRoot(container)
+}
+
+// Roots returns all the registered roots.
+func Roots() []*Root {
+	rootsMu.Lock()
+	defer rootsMu.Unlock()
+
+	r := make([]*Root, len(roots))
+	copy(r, roots)
+	return r
+}
+
+// UnmountAll unmounts all the registered roots.
+func UnmountAll() {
+	for _, r := range Roots() {
+		r.Unmount()
+	}
+}
+
+// Container returns the DOM container of this Root.
+func (r *Root) Container() *js.Object {
+	return r.container
+}
+
+// Render renders the component into the container. If the component is a Factory,
+// an element is created with the given props, else the props are ignored.
+func (r *Root) Render(c Component, props Props) {
+	r.component = c
+	r.props = props
+	r.render()
+}
+
+// Update re-renders the last rendered component with the given props.
+func (r *Root) Update(props Props) {
+	if r.component == nil {
+		panic("Nothing rendered into this Root")
+	}
+	r.props = props
+	r.render()
+}
+
+// Unmount unmounts the React tree from the container and removes this Root
+// from the registry. It reports whether a tree was unmounted.
+// A later Render mounts a new tree and registers the Root again.
+func (r *Root) Unmount() bool {
+	rootsMu.Lock()
+	for i, rr := range roots {
+		if rr == r {
+			roots = append(roots[:i], roots[i+1:]...)
+			break
+		}
+	}
+	rootsMu.Unlock()
+
+	mounted := r.component != nil
+	r.component = nil
+
+	if r.reactRoot != nil {
+		// A React 18 root cannot be reused after unmount.
+		r.reactRoot.Call("unmount")
+		r.reactRoot = nil
+		return mounted
+	}
+
+	return reactDOM.Call("unmountComponentAtNode", r.container).Bool()
+}
+
+func (r *Root) render() {
+	r.register()
+
+	var elem *Element
+
+	if f, ok := r.component.(Factory); ok {
+		elem = f.CreateElement(r.props)
+	} else {
+		elem = CreateIfNeeded(r.component)
+	}
+
+	if r.reactRoot != nil {
+		r.reactRoot.Call("render", elem.Node())
+		return
+	}
+
+	reactDOM.Call("render", elem.Node(), r.container)
+}
+
+// register adds an unmounted Root back to the registry.
+func (r *Root) register() {
+	rootsMu.Lock()
+	defer rootsMu.Unlock()
+
+	for _, rr := range roots {
+		if rr == r {
+			return
+		}
+	}
+
+	if r.reactRoot == nil {
+		r.reactRoot = createRoot(r.container)
+	}
+	roots = append(roots, r)
+}
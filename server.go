@@ -0,0 +1,93 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bep/gr/support"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+var reactDOMServer *js.Object
+
+// RenderToString renders the given component with the given props to its initial HTML.
+// This is typically done on the server (Node.js) to pre-render pages, and the markup
+// can later be attached to on the client.
+//
+// Props are only applied if the component is a Factory; elements are rendered as is.
+//
+// See https://facebook.github.io/react/docs/react-dom-server.html#rendertostring
+func RenderToString(c Component, props Props) (string, error) {
+	return renderServer("renderToString", c, props)
+}
+
+// RenderToStaticMarkup is similar to RenderToString, except that it doesn't create the extra
+// DOM attributes that React uses internally. Use this for simple static page generators.
+//
+// See https://facebook.github.io/react/docs/react-dom-server.html#rendertostaticmarkup
+func RenderToStaticMarkup(c Component, props Props) (string, error) {
+	return renderServer("renderToStaticMarkup", c, props)
+}
+
+func renderServer(method string, c Component, props Props) (s string, err error) {
+	if c == nil {
+		return "", errors.New("Must provide a component to render")
+	}
+
+	server, err := loadReactDOMServer()
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Failed to render %T: %v", c, r)
+		}
+	}()
+
+	var elem *Element
+
+	if f, ok := c.(Factory); ok {
+		elem = f.CreateElement(props)
+	} else {
+		elem = CreateIfNeeded(c)
+	}
+
+	return server.Call(method, elem.Node()).String(), nil
+}
+
+func loadReactDOMServer() (*js.Object, error) {
+	if reactDOMServer != nil {
+		return reactDOMServer, nil
+	}
+
+	if s := js.Global.Get("ReactDOMServer"); s != js.Undefined {
+		reactDOMServer = s
+		return s, nil
+	}
+
+	s, err := support.Require("react-dom/server")
+	if err != nil {
+		return nil, fmt.Errorf("Cannot find ReactDOMServer: %s", err)
+	}
+
+	reactDOMServer = s
+
+	return s, nil
+}
@@ -19,13 +19,13 @@ package tests
 import (
 	"fmt"
 	"sort"
+	"strings"
 	"testing"
 
-	"time"
-
 	"github.com/bep/gr"
 	"github.com/bep/gr/attr"
 	"github.com/bep/gr/el"
+	"github.com/bep/gr/evt"
 	"github.com/bep/gr/tests/grt"
 	"github.com/gopherjs/gopherjs/js"
 )
@@ -100,7 +100,7 @@ func TestNew(t *testing.T) {
 	// 2) ShouldComponentUpdate
 	r.ReRender(newProps)
 
-	time.Sleep(200 * time.Millisecond)
+	grt.Flush()
 	//component.printVisits()
 	// TODO(bep) Verify that this is the expected behavior in this case.
 	// TODO(bep) Find a way to check the other methods.
@@ -108,6 +108,94 @@ func TestNew(t *testing.T) {
 
 }
 
+func TestNewFunc(t *testing.T) {
+	rc := gr.NewFunc(func(props gr.Props, children *gr.Children) gr.Component {
+		return el.Div(
+			el.Header1(gr.Text(props.String("title"))),
+			children.Element(),
+		)
+	}, gr.DisplayName("TestFunc"))
+
+	elem := rc.CreateElement(gr.Props{"title": "Func Title"}, el.Paragraph(gr.Text("Func Child")))
+	r := grt.ShallowRender(elem)
+
+	grt.Equal(t, "<div><h1>Func Title</h1><p>Func Child</p></div>", r.String())
+	grt.Equal(t, "TestFunc", rc.Node().Get("type").Get("displayName").String())
+}
+
+func TestPure(t *testing.T) {
+	grt.RequireReact(t, 16, 0)
+
+	for _, test := range []struct {
+		option        gr.Option
+		expectRenders int
+	}{
+		// The slice prop is a new JavaScript array on every render.
+		{gr.Pure(), 3},
+		{gr.DeepCompare(), 2},
+	} {
+		c := &thisCompRenderCounter{}
+		rc := gr.New(c, test.option)
+
+		props := func(a string) gr.Props {
+			return gr.Props{"a": a, "items": []string{"i1", "i2"}}
+		}
+
+		tree := grt.FullRender(rc.CreateElement(props("a1")))
+		grt.Equal(t, 1, c.renders)
+
+		tree.Update(rc.CreateElement(props("a1")))
+		tree.Update(rc.CreateElement(props("a2")))
+
+		grt.Equal(t, test.expectRenders, c.renders)
+
+		tree.Unmount()
+	}
+}
+
+func TestMemo(t *testing.T) {
+	grt.RequireReact(t, 16, 6)
+
+	renders := 0
+
+	rc := gr.Memo(func(props gr.Props, children *gr.Children) gr.Component {
+		renders++
+		return el.Div(gr.Text(props.String("title")))
+	}, gr.DisplayName("TestMemo"))
+
+	tree := grt.FullRender(rc.CreateElement(gr.Props{"title": "t1"}))
+	tree.Update(rc.CreateElement(gr.Props{"title": "t1"}))
+
+	grt.Equal(t, 1, renders)
+
+	tree.Update(rc.CreateElement(gr.Props{"title": "t2"}))
+
+	grt.Equal(t, 2, renders)
+	grt.Equal(t, `{"type":"div","props":{},"children":["t2"]}`, tree.JSON())
+}
+
+func TestPureOnFunc(t *testing.T) {
+	render := func(props gr.Props, children *gr.Children) gr.Component {
+		return el.Div()
+	}
+
+	for _, opt := range []gr.Option{gr.Pure(), gr.DeepCompare()} {
+		func() {
+			defer func() {
+				r := recover()
+				grt.NotNil(t, r)
+				grt.Equal(t, true, strings.Contains(fmt.Sprint(r), "Memo"))
+			}()
+			gr.NewFunc(render, opt)
+		}()
+	}
+
+	grt.RequireReact(t, 16, 6)
+
+	// But they are fine with Memo.
+	gr.Memo(render, gr.DeepCompare())
+}
+
 func TestCloneElement(t *testing.T) {
 	c := gr.New(&testTwoButtons{})
 
@@ -222,7 +310,7 @@ func TestForceUpdate(t *testing.T) {
 
 	this.ForceUpdate()
 
-	time.Sleep(200 * time.Millisecond)
+	grt.Flush()
 
 	grt.Equal(t, `<div><button style={{"color": "indigo"}}>Initial Button</button></div>`,
 		r.String())
@@ -254,6 +342,190 @@ func TestContext(t *testing.T) {
 	grt.Equal(t, `<button id={42} style={{"color": "green"}} />`, c.String())
 }
 
+func TestDefaultProps(t *testing.T) {
+	c := gr.New(&testTwoButtonsWithDefaults{})
+
+	r := grt.ShallowRender(c.CreateElement(gr.Props{"b2": "b2-set"}))
+	grt.Equal(t, "<div><button>b1-default</button><button>b2-set</button></div>", r.String())
+
+	r = grt.ShallowRender(c.CreateElement(nil))
+	grt.Equal(t, "<div><button>b1-default</button><button>b2-default</button></div>", r.String())
+}
+
+type testTwoButtonsWithDefaults struct {
+	*gr.This
+}
+
+func (c *testTwoButtonsWithDefaults) Render() gr.Component {
+	return el.Div(
+		el.Button(gr.Text(c.Props().String("b1"))),
+		el.Button(gr.Text(c.Props().String("b2"))),
+	)
+}
+
+func (c *testTwoButtonsWithDefaults) GetDefaultProps() gr.Props {
+	return gr.Props{"b1": "b1-default", "b2": "b2-default"}
+}
+
+func TestWithPropTypes(t *testing.T) {
+	propTypes := js.Global.Get("PropTypes")
+
+	for _, template := range []interface{}{testPropTypesProps{}, &testPropTypesProps{}} {
+		rc := gr.New(&testTwoButtons{}, gr.WithPropTypes(template))
+		pt := rc.Node().Get("type").Get("propTypes")
+
+		grt.Equal(t, propTypes.Get("string").Get("isRequired"), pt.Get("b1"))
+		grt.Equal(t, propTypes.Get("string"), pt.Get("b2"))
+		grt.Equal(t, propTypes.Get("bool"), pt.Get("enabled"))
+		grt.Equal(t, propTypes.Get("func"), pt.Get("onClick"))
+		grt.Equal(t, propTypes.Get("element"), pt.Get("icon"))
+		grt.Equal(t, propTypes.Get("node"), pt.Get("child"))
+		grt.NotNil(t, pt.Get("counts"))
+		grt.NotNil(t, pt.Get("owner"))
+	}
+
+	rc := gr.New(&testTwoButtons{}, gr.WithPropTypes(gr.Props{"b1": "", "b2": 32}))
+	pt := rc.Node().Get("type").Get("propTypes")
+
+	grt.Equal(t, propTypes.Get("string"), pt.Get("b1"))
+	grt.Equal(t, propTypes.Get("number"), pt.Get("b2"))
+}
+
+type testPropTypesComp struct {
+	*gr.This
+}
+
+func (c *testPropTypesComp) Render() gr.Component {
+	var p testPropTypesProps
+	if err := c.PropsInto(&p); err != nil {
+		panic(err)
+	}
+	var mods []gr.Modifier
+	if p.Icon != nil {
+		mods = append(mods, p.Icon)
+	}
+	if p.Child != nil {
+		mods = append(mods, gr.CreateIfNeeded(p.Child))
+	}
+	return el.Div(append(mods, gr.Text(p.B1))...)
+}
+
+func TestWithPropTypesValidation(t *testing.T) {
+	var warnings []string
+
+	console := js.Global.Get("console")
+	consoleError := console.Get("error")
+	defer console.Set("error", consoleError)
+	console.Set("error", func(args ...interface{}) {
+		warnings = append(warnings, fmt.Sprint(args...))
+	})
+
+	rc := gr.New(&testPropTypesComp{}, gr.WithPropTypes(testPropTypesProps{}))
+
+	props, err := gr.PropsFrom(testPropTypesProps{
+		B1:    "text",
+		Icon:  el.Italic(gr.Text("icon")),
+		Child: el.Bold(gr.Text("child")),
+	})
+	grt.Equal(t, nil, err)
+
+	tree := grt.ShallowRender(rc.CreateElement(props))
+
+	grt.Equal(t, "<div><i>icon</i><b>child</b>text</div>", tree.String())
+
+	// Element values in Props work the same.
+	tree = grt.ShallowRender(rc.CreateElement(gr.Props{"b1": "text2", "icon": el.Italic(gr.Text("icon2"))}))
+
+	grt.Equal(t, "<div><i>icon2</i>text2</div>", tree.String())
+
+	grt.Equal(t, 0, len(warnings))
+
+	// But invalid props are still reported.
+	grt.ShallowRender(rc.CreateElement(gr.Props{"b1": "text", "icon": "not an element"}))
+
+	grt.Equal(t, 1, len(warnings))
+}
+
+type testPropElementOwner struct {
+	*gr.This
+	clicks int
+	this   *gr.This
+}
+
+func (c *testPropElementOwner) Render() gr.Component {
+	icon := el.Button(evt.Click(func(e *gr.Event) {
+		c.clicks++
+		c.this = e.This
+	}))
+
+	return gr.New(&testPropTypesComp{}).CreateElement(gr.Props{"b1": "text", "icon": icon})
+}
+
+func TestPropElementListeners(t *testing.T) {
+	c := &testPropElementOwner{}
+	tree := grt.ShallowRender(gr.New(c).CreateElement(nil))
+
+	// The listeners on an element passed in props are bound to the owner.
+	tree.Props.Get("icon").Get("props").Call("onClick", js.M{})
+
+	grt.Equal(t, 1, c.clicks)
+	grt.Equal(t, tree.This().This, c.this.This)
+}
+
+type testPropTypesProps struct {
+	B1      string       `gr:"b1,required"`
+	B2      string       `gr:"b2"`
+	Enabled bool         `gr:"enabled"`
+	OnClick func()       `gr:"onClick"`
+	Icon    *gr.Element  `gr:"icon"`
+	Child   gr.Component `gr:"child"`
+	Counts  []int        `gr:"counts"`
+	Owner   struct {
+		Name string `gr:"name"`
+	} `gr:"owner"`
+}
+
+func TestErrorBoundary(t *testing.T) {
+	grt.RequireReact(t, 16, 0)
+
+	boundary := &testErrorBoundary{child: gr.New(new(testPanicker))}
+	rc := gr.New(boundary)
+
+	tree := grt.FullRender(rc.CreateElement(nil))
+
+	grt.Equal(t, `{"type":"div","props":{},"children":["Caught: tests.testPanicker.Render: Go panic"]}`, tree.JSON())
+	grt.NotNil(t, boundary.err)
+}
+
+type testErrorBoundary struct {
+	*gr.This
+	child gr.Factory
+	err   error
+}
+
+func (b *testErrorBoundary) Render() gr.Component {
+	if msg := b.State().String("error"); msg != "" {
+		return el.Div(gr.Text("Caught: " + msg))
+	}
+	return el.Div(b.child.CreateElement(nil))
+}
+
+func (b *testErrorBoundary) GetDerivedStateFromError(err error) gr.State {
+	return gr.State{"error": err.(*js.Error).Get("message").String()}
+}
+
+func (b *testErrorBoundary) ComponentDidCatch(err error, info gr.ErrorInfo) {
+	b.err = err
+}
+
+type testPanicker struct {
+	*gr.This
+}
+
+func (p *testPanicker) Render() gr.Component {
+	panic("Go panic")
+}
+
 func resetComponentState() {
 	js.Global.Set(exportedTestComponent, nil)
 	js.Module.Get("exports").Set(exportedTestComponent, nil)
@@ -1,8 +1,10 @@
 
-global.Hello = React.createClass({
+var createClass = React.createClass || require('create-react-class');
+
+global.Hello = createClass({
     render: function () {
 		var message = this.props.message
-        return React.DOM.h1(null, message)
+        return React.createElement('h1', null, message)
     }
 
 });
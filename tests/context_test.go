@@ -0,0 +1,162 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/bep/gr"
+	"github.com/bep/gr/el"
+	"github.com/bep/gr/evt"
+	"github.com/bep/gr/tests/grt"
+)
+
+type testTheme struct {
+	Name string
+}
+
+type contextComp struct {
+	*gr.This
+	ct *gr.ContextType
+}
+
+func (c *contextComp) Render() gr.Component {
+	return el.Span(gr.Text("class:" + c.ContextOf(c.ct).(*testTheme).Name))
+}
+
+func TestCreateContext(t *testing.T) {
+	// UseContext is a hook, and hooks arrived in React 16.8.
+	grt.RequireReact(t, 16, 8)
+
+	ct := gr.CreateContext(&testTheme{Name: "light"})
+
+	class := gr.New(&contextComp{ct: ct}, gr.WithContextType(ct))
+
+	fn := gr.NewFunc(func(props gr.Props, children *gr.Children) gr.Component {
+		return el.Span(gr.Text("func:" + gr.UseContext(ct).(*testTheme).Name))
+	})
+
+	consumer := ct.Consumer(func(value interface{}) gr.Component {
+		return el.Span(gr.Text("consumer:" + value.(*testTheme).Name))
+	})
+
+	tree := grt.FullRender(el.Div(
+		ct.Provider(&testTheme{Name: "dark"},
+			class.CreateElement(nil),
+			fn.CreateElement(nil),
+			consumer,
+		),
+		fn.CreateElement(nil),
+	))
+
+	grt.Equal(t, `{"type":"div","props":{},"children":[`+
+		`{"type":"span","props":{},"children":["class:dark"]},`+
+		`{"type":"span","props":{},"children":["func:dark"]},`+
+		`{"type":"span","props":{},"children":["consumer:dark"]},`+
+		`{"type":"span","props":{},"children":["func:light"]}]}`, tree.JSON())
+}
+
+type contextClickComp struct {
+	*gr.This
+	ct     *gr.ContextType
+	clicks []string
+	this   *gr.This
+}
+
+func (c *contextClickComp) Render() gr.Component {
+	click := func(name string) gr.Modifier {
+		return evt.Click(func(e *gr.Event) {
+			c.clicks = append(c.clicks, name)
+			c.this = e.This
+		})
+	}
+
+	return el.Div(
+		c.ct.Provider(&testTheme{Name: "dark"},
+			el.Button(gr.Text("provider"), click("provider")),
+			c.ct.Consumer(func(value interface{}) gr.Component {
+				return el.Anchor(gr.Text("consumer"), click("consumer:"+value.(*testTheme).Name))
+			}),
+			c.ct.Consumer(func(value interface{}) gr.Component {
+				return el.Span(gr.Text("consumer2"), click("consumer2:"+value.(*testTheme).Name))
+			}),
+		),
+	)
+}
+
+func TestContextEventListeners(t *testing.T) {
+	grt.RequireReact(t, 16, 3)
+
+	c := &contextClickComp{ct: gr.CreateContext(&testTheme{Name: "light"})}
+	tree := grt.FullRender(gr.New(c).CreateElement(nil))
+
+	tree.CallEventListener("button", "onClick")
+	tree.CallEventListener("a", "onClick")
+	tree.CallEventListener("span", "onClick")
+
+	grt.Equal(t, 3, len(c.clicks))
+	grt.Equal(t, "provider", c.clicks[0])
+	grt.Equal(t, "consumer:dark", c.clicks[1])
+	grt.Equal(t, "consumer2:dark", c.clicks[2])
+	grt.Equal(t, tree.Instance().This, c.this.This)
+}
+
+type contextPureComp struct {
+	*gr.This
+	ct      *gr.ContextType
+	renders int
+}
+
+func (c *contextPureComp) Render() gr.Component {
+	c.renders++
+	return el.Span(gr.Text(c.ContextOf(c.ct).(*testTheme).Name))
+}
+
+// Only a changed context value will re-render.
+func (c *contextPureComp) ShouldComponentUpdate(next gr.Cops) bool {
+	return false
+}
+
+func TestContextValueIdentity(t *testing.T) {
+	grt.RequireReact(t, 16, 6)
+
+	ct := gr.CreateContext(&testTheme{Name: "light"})
+	child := &contextPureComp{ct: ct}
+	pure := gr.New(child, gr.WithContextType(ct))
+
+	dark, light := &testTheme{Name: "dark"}, &testTheme{Name: "light"}
+
+	parent := gr.NewFunc(func(props gr.Props, children *gr.Children) gr.Component {
+		theme := dark
+		if props.String("theme") == "light" {
+			theme = light
+		}
+		return el.Div(gr.Text(props.String("n")), ct.Provider(theme, pure.CreateElement(nil)))
+	})
+
+	tree := grt.FullRender(parent.CreateElement(gr.Props{"n": "1"}))
+	grt.Equal(t, 1, child.renders)
+
+	// Same value, the pure child should not re-render.
+	tree.Update(parent.CreateElement(gr.Props{"n": "2"}))
+	grt.Equal(t, 1, child.renders)
+
+	tree.Update(parent.CreateElement(gr.Props{"n": "3", "theme": "light"}))
+	grt.Equal(t, 2, child.renders)
+	grt.Equal(t, `{"type":"div","props":{},"children":["3",`+
+		`{"type":"span","props":{},"children":["light"]}]}`, tree.JSON())
+}
@@ -17,11 +17,17 @@ limitations under the License.
 package tests
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/bep/gr"
+	"github.com/bep/gr/attr"
 	"github.com/bep/gr/el"
+	"github.com/bep/gr/evt"
+	"github.com/bep/gr/support"
 	"github.com/bep/gr/tests/grt"
+	"github.com/gopherjs/gopherjs/js"
 )
 
 func TestRenderButton(t *testing.T) {
@@ -71,3 +77,192 @@ func TestRenderRegularTextLast(t *testing.T) {
 
 	grt.Equal(t, "<div><b>Bold</b><i>Italic</i>Regular</div>", tree.String())
 }
+
+func TestFragment(t *testing.T) {
+	grt.RequireReact(t, 16, 2)
+
+	list := el.UnorderedList(
+		gr.Fragment(
+			el.ListItem(gr.Text("A")),
+			el.ListItem(gr.Text("B")),
+		),
+		gr.KeyedFragment("k", el.ListItem(gr.Text("C"))),
+	)
+
+	tree := grt.FullRender(gr.NewSimpleComponent(list).CreateElement(nil))
+
+	grt.Equal(t, `{"type":"ul","props":{},"children":[{"type":"li","props":{},"children":["A"]},{"type":"li","props":{},"children":["B"]},{"type":"li","props":{},"children":["C"]}]}`, tree.JSON())
+}
+
+func TestMap(t *testing.T) {
+	items := []string{"b", "a", "skip", "c"}
+
+	list := el.UnorderedList(
+		gr.Map(items,
+			func(i int) string { return "key-" + items[i] },
+			func(i int) gr.Component {
+				if items[i] == "skip" {
+					return nil
+				}
+				return el.ListItem(el.Bold(gr.Text(items[i])))
+			},
+		),
+		// A prepared element needs to be cloned to get the key.
+		gr.Map(items[:1],
+			func(i int) string { return "prepared" },
+			func(i int) gr.Component { return gr.NewPreparedElement(el.ListItem(gr.Text("p")).Node()) },
+		),
+	)
+
+	tree := grt.ShallowRender(list)
+
+	grt.Equal(t, "<ul><li><b>b</b></li><li><b>a</b></li><li><b>c</b></li><li>p</li></ul>", tree.String())
+
+	children := list.Node().Get("props").Get("children")
+	var keys []string
+	for i := 0; i < children.Length(); i++ {
+		keys = append(keys, children.Index(i).Get("key").String())
+	}
+
+	grt.Equal(t, "key-b key-a key-c prepared", strings.Join(keys, " "))
+}
+
+func TestMapDuplicateKeys(t *testing.T) {
+	var errors []string
+
+	console := js.Global.Get("console")
+	consoleError := console.Get("error")
+	console.Set("error", func(msg string) {
+		errors = append(errors, msg)
+	})
+	defer console.Set("error", consoleError)
+
+	items := []string{"a", "b"}
+	list := el.UnorderedList(
+		gr.Map(items, func(i int) string { return "dup" }, func(i int) gr.Component { return el.ListItem() }),
+	)
+
+	grt.Equal(t, 2, list.Node().Get("props").Get("children").Length())
+	grt.Equal(t, 1, len(errors))
+	grt.Equal(t, true, strings.Contains(errors[0], `Duplicate key "dup"`))
+}
+
+// stubPortals replaces document.getElementById and ReactDOM.createPortal, which
+// react-test-renderer does not support, with stubs that record the calls and
+// render the child in place.
+func stubPortals(t *testing.T, ids ...string) (calls *[]js.M, restore func()) {
+	grt.RequireReact(t, 16, 0)
+
+	var recorded []js.M
+
+	document := js.Global.Get("document")
+
+	containers := make(map[string]js.M)
+	for _, id := range ids {
+		containers[id] = js.M{"id": id}
+	}
+
+	js.Global.Set("document", js.M{
+		"getElementById": func(id string) interface{} {
+			if c, ok := containers[id]; ok {
+				return c
+			}
+			return nil
+		},
+	})
+
+	reactDOM := reactDOMModule()
+	createPortal := reactDOM.Get("createPortal")
+	reactDOM.Set("createPortal", func(child, container, key *js.Object) *js.Object {
+		recorded = append(recorded, js.M{"container": container.Get("id").String(), "key": key})
+		return child
+	})
+
+	return &recorded, func() {
+		if document == js.Undefined {
+			js.Global.Delete("document")
+		} else {
+			js.Global.Set("document", document)
+		}
+		reactDOM.Set("createPortal", createPortal)
+	}
+}
+
+func reactDOMModule() *js.Object {
+	if r := js.Global.Get("ReactDOM"); r != js.Undefined {
+		return r
+	}
+	r, err := support.Require("react-dom")
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+type portalComp struct {
+	*gr.This
+	clicks    int
+	clickThis *gr.This
+}
+
+func (c *portalComp) Render() gr.Component {
+	portal := gr.Portal("modal", el.Button(
+		gr.Text("Close"),
+		evt.Click(func(e *gr.Event) {
+			c.clicks++
+			c.clickThis = e.This
+		})))
+	attr.Key("modal-key").Modify(portal)
+
+	return el.Div(portal)
+}
+
+func TestPortal(t *testing.T) {
+	calls, restore := stubPortals(t, "modal")
+	defer restore()
+
+	c := &portalComp{}
+	tree := grt.FullRender(gr.New(c).CreateElement(nil))
+
+	grt.Equal(t, `{"type":"div","props":{},"children":[`+
+		`{"type":"button","props":{},"children":["Close"]}]}`, tree.JSON())
+
+	grt.Equal(t, 1, len(*calls))
+	grt.Equal(t, "modal", (*calls)[0]["container"])
+	grt.Equal(t, "modal-key", (*calls)[0]["key"].(*js.Object).String())
+
+	// Listeners in the portal are bound to the component rendering it.
+	tree.CallEventListener("button", "onClick")
+
+	grt.Equal(t, 1, c.clicks)
+	grt.Equal(t, tree.Instance().This, c.clickThis.This)
+}
+
+func TestPortalContainerNotFound(t *testing.T) {
+	_, restore := stubPortals(t)
+	defer restore()
+
+	defer func() {
+		r := recover()
+		grt.NotNil(t, r)
+		grt.Equal(t, `Portal container "missing" not found`, fmt.Sprint(r))
+	}()
+
+	gr.Portal("missing", el.Div()).Node()
+}
+
+func TestPortalWithEventListener(t *testing.T) {
+	_, restore := stubPortals(t, "modal")
+	defer restore()
+
+	portal := gr.Portal("modal", el.Div())
+	evt.Click(func(e *gr.Event) {}).Modify(portal)
+
+	defer func() {
+		r := recover()
+		grt.NotNil(t, r)
+		grt.Equal(t, true, strings.Contains(fmt.Sprint(r), "cannot be attached to a Fragment, a Portal"))
+	}()
+
+	grt.FullRender(gr.NewSimpleComponent(el.Div(portal)).CreateElement(nil))
+}
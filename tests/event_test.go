@@ -18,11 +18,13 @@ package tests
 
 import (
 	"testing"
+	"time"
 
 	"github.com/bep/gr"
 	"github.com/bep/gr/el"
 	"github.com/bep/gr/evt"
 	"github.com/bep/gr/tests/grt"
+	"github.com/gopherjs/gopherjs/js"
 )
 
 func TestClickableButton(t *testing.T) {
@@ -49,3 +51,254 @@ func TestClickableButton(t *testing.T) {
 	grt.Equal(t, 42, clickCount)
 
 }
+
+func TestTypedEvents(t *testing.T) {
+	var (
+		mouse    *gr.MouseEvent
+		keyboard *gr.KeyboardEvent
+		touch    *gr.TouchEvent
+		wheel    *gr.WheelEvent
+	)
+
+	div := el.Div(
+		gr.Text("Typed"),
+		evt.ClickM(func(e *gr.MouseEvent) { mouse = e }),
+		evt.KeyDownK(func(e *gr.KeyboardEvent) { keyboard = e }),
+		evt.TouchStartT(func(e *gr.TouchEvent) { touch = e }),
+		evt.WheelW(func(e *gr.WheelEvent) { wheel = e }),
+	)
+
+	tree := grt.ShallowRender(gr.NewSimpleComponent(div).CreateElement(nil))
+
+	tree.CallEventListener("onClick", js.M{"clientX": 10, "clientY": 20, "buttons": 1, "shiftKey": true})
+	tree.CallEventListener("onKeyDown", js.M{"key": "a", "repeat": true, "nativeEvent": js.M{"code": "KeyA"}})
+	tree.CallEventListener("onTouchStart", js.M{"touches": []js.M{{"identifier": 3, "clientX": 30}}})
+	tree.CallEventListener("onWheel", js.M{"deltaY": 2.5, "ctrlKey": true})
+
+	grt.Equal(t, 10, mouse.ClientX())
+	grt.Equal(t, 20, mouse.ClientY())
+	grt.Equal(t, 1, mouse.Buttons())
+	grt.Equal(t, true, mouse.ShiftKey())
+	grt.Equal(t, false, mouse.AltKey())
+
+	grt.Equal(t, "a", keyboard.Key())
+	grt.Equal(t, "KeyA", keyboard.Code())
+	grt.Equal(t, true, keyboard.Repeat())
+
+	grt.Equal(t, 1, len(touch.Touches()))
+	grt.Equal(t, 3, touch.Touches()[0].Identifier())
+	grt.Equal(t, 30, touch.Touches()[0].ClientX())
+	grt.Equal(t, 0, len(touch.ChangedTouches()))
+
+	grt.Equal(t, 2.5, wheel.DeltaY())
+	grt.Equal(t, true, wheel.CtrlKey())
+}
+
+func TestCaptureAndOnce(t *testing.T) {
+	var captured, clicks int
+
+	onceListener := evt.Click(func(event *gr.Event) {
+		clicks++
+	}).Once()
+
+	button := el.Button(
+		gr.Text("Capture Button"),
+		evt.ClickCapture(func(event *gr.Event) {
+			captured++
+		}),
+		onceListener,
+	)
+
+	tree := grt.ShallowRender(gr.NewSimpleComponent(button).CreateElement(nil))
+
+	for i := 0; i < 3; i++ {
+		tree.CallEventListener("onClickCapture")
+		tree.CallEventListener("onClick")
+	}
+
+	grt.NotNil(t, tree.Props.Get("onClickCapture"))
+	grt.Equal(t, 3, captured)
+	grt.Equal(t, 1, clicks)
+}
+
+func TestOnceAcrossRenders(t *testing.T) {
+	grt.RequireReact(t, 16, 0)
+
+	var clicks, spanClicks int
+
+	rc := gr.NewFunc(func(props gr.Props, children *gr.Children) gr.Component {
+		var mods []gr.Modifier
+		if props.Bool("span") {
+			// Renders another Once listener before the button.
+			mods = append(mods, el.Span(evt.Click(func(event *gr.Event) {
+				spanClicks++
+			}).Once()))
+		}
+		mods = append(mods, el.Button(
+			gr.Text(props.String("text")),
+			evt.Click(func(event *gr.Event) {
+				clicks++
+			}).Once(),
+		))
+		return el.Div(mods...)
+	})
+
+	// The DOM node the listener is attached to.
+	button := js.Global.Get("Object").New()
+	event := js.M{"currentTarget": button}
+
+	tree := grt.FullRender(rc.CreateElement(gr.Props{"text": "a"}))
+	tree.CallEventListener("button", "onClick", event)
+	grt.Equal(t, 1, clicks)
+
+	tree.Update(rc.CreateElement(gr.Props{"text": "b", "span": true}))
+	tree.CallEventListener("button", "onClick", event)
+	tree.CallEventListener("span", "onClick", js.M{"currentTarget": js.Global.Get("Object").New()})
+
+	grt.Equal(t, 1, clicks)
+	grt.Equal(t, 1, spanClicks)
+
+	// Another node, e.g. after a remount, has its own state.
+	tree.CallEventListener("button", "onClick", js.M{"currentTarget": js.Global.Get("Object").New()})
+	grt.Equal(t, 2, clicks)
+}
+
+// stubLongTimers replaces setTimeout and clearTimeout for timeouts of a minute
+// or more, so the tests can run them without waiting. Shorter timeouts, e.g. the
+// ones used by the Go runtime, are passed on.
+func stubLongTimers() (timers *js.Object, restore func()) {
+	stub := js.Global.Get("Function").New("g", `
+var setTimeout0 = g.setTimeout, clearTimeout0 = g.clearTimeout;
+var pending = {}, next = 0;
+g.setTimeout = function(f, t) {
+	if (t < 60000) {
+		return setTimeout0.apply(g, arguments);
+	}
+	next++;
+	pending[next] = f;
+	return {grTimer: next};
+};
+g.clearTimeout = function(id) {
+	if (id && id.grTimer) {
+		delete pending[id.grTimer];
+		return;
+	}
+	return clearTimeout0.apply(g, arguments);
+};
+return {
+	run: function() {
+		var ids = Object.keys(pending).map(Number).sort(function(a, b) { return a - b; });
+		ids.forEach(function(id) {
+			var f = pending[id];
+			delete pending[id];
+			f();
+		});
+		return ids.length;
+	},
+	restore: function() {
+		g.setTimeout = setTimeout0;
+		g.clearTimeout = clearTimeout0;
+	}
+};`).Invoke(js.Global)
+
+	return stub, func() { stub.Call("restore") }
+}
+
+type debounceComp struct {
+	*gr.This
+	clicks int
+}
+
+func (c *debounceComp) Render() gr.Component {
+	return el.Button(
+		gr.Text("Debounced"),
+		evt.Click(func(event *gr.Event) {
+			c.clicks++
+		}).Debounce(time.Hour),
+	)
+}
+
+func TestDebounceAndThrottle(t *testing.T) {
+	grt.RequireReact(t, 16, 0)
+
+	timers, restore := stubLongTimers()
+	defer restore()
+
+	var throttled int
+
+	button := el.Button(
+		gr.Text("Throttled"),
+		evt.Click(func(event *gr.Event) {
+			throttled++
+		}).Throttle(time.Hour),
+	)
+
+	tree := grt.ShallowRender(gr.NewSimpleComponent(button).CreateElement(nil))
+
+	for i := 0; i < 3; i++ {
+		tree.CallEventListener("onClick", js.M{})
+	}
+
+	// The first immediately, the last when the period ends.
+	grt.Equal(t, 1, throttled)
+	grt.Equal(t, 1, timers.Call("run").Int())
+	grt.Equal(t, 2, throttled)
+
+	c := &debounceComp{}
+	full := grt.FullRender(gr.New(c).CreateElement(nil))
+
+	for i := 0; i < 3; i++ {
+		full.CallEventListener("button", "onClick", js.M{})
+	}
+
+	// Only the last is kept.
+	grt.Equal(t, 0, c.clicks)
+	grt.Equal(t, 1, timers.Call("run").Int())
+	grt.Equal(t, 1, c.clicks)
+
+	// Pending calls are dropped on unmount.
+	full.CallEventListener("button", "onClick", js.M{})
+	full.Unmount()
+	grt.Equal(t, 1, timers.Call("run").Int())
+	grt.Equal(t, 1, c.clicks)
+}
+
+func TestDebounceFunc(t *testing.T) {
+	grt.RequireReact(t, 16, 0)
+
+	timers, restore := stubLongTimers()
+	defer restore()
+
+	var clicks int
+
+	rc := gr.NewFunc(func(props gr.Props, children *gr.Children) gr.Component {
+		return el.Button(
+			gr.Text(props.String("text")),
+			evt.Click(func(event *gr.Event) {
+				clicks++
+			}).Debounce(time.Hour),
+		)
+	})
+
+	// The DOM node the listener is attached to.
+	button := js.Global.Get("Object").New()
+	button.Set("isConnected", true)
+	event := js.M{"currentTarget": button}
+
+	tree := grt.FullRender(rc.CreateElement(gr.Props{"text": "a"}))
+
+	// The debounce state must survive the re-render.
+	tree.CallEventListener("button", "onClick", event)
+	tree.Update(rc.CreateElement(gr.Props{"text": "b"}))
+	tree.CallEventListener("button", "onClick", event)
+
+	grt.Equal(t, 1, timers.Call("run").Int())
+	grt.Equal(t, 1, clicks)
+
+	// Pending calls are dropped when the node is removed from the document.
+	tree.CallEventListener("button", "onClick", event)
+	tree.Unmount()
+	button.Set("isConnected", false)
+	grt.Equal(t, 1, timers.Call("run").Int())
+	grt.Equal(t, 1, clicks)
+}
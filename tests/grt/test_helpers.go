@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/bep/gr"
+	"github.com/bep/gr/support"
 	"github.com/gopherjs/gopherjs/js"
 )
 
@@ -38,6 +39,24 @@ func NotNil(t *testing.T, val interface{}) {
 	}
 }
 
+// RequireReact skips the test if the React version in use is older than the given.
+func RequireReact(t *testing.T, major, minor int) {
+	var vmajor, vminor int
+	fmt.Sscanf(gr.ReactVersion(), "%d.%d", &vmajor, &vminor)
+	if vmajor < major || (vmajor == major && vminor < minor) {
+		t.Skipf("Requires React %d.%d, got %s", major, minor, gr.ReactVersion())
+	}
+}
+
+// Flush waits for pending state updates (see gr.This.SetState) and for the
+// goroutines started by lifecycle methods and event listeners to run.
+func Flush() {
+	done := make(chan struct{})
+	// A timer runs after both the queued microtasks and goroutines.
+	js.Global.Call("setTimeout", func() { close(done) }, 0)
+	<-done
+}
+
 // Fail fails the test with the given message.
 func Fail(t *testing.T, args ...interface{}) {
 	t.Fatal(args)
@@ -68,6 +87,75 @@ func (t *RenderedTree) Dive(path ...string) *RenderedTree {
 	return &RenderedTree{Object: tree, context: t.context}
 }
 
+// FullTree represents a full, deep render using react-test-renderer.
+type FullTree struct {
+	*js.Object
+}
+
+// FullRender performs a full render of the given component, including all
+// its descendants. This requires react-test-renderer.
+func FullRender(c gr.Component) *FullTree {
+	if _, ok := c.(gr.Factory); ok {
+		panic("Cannot render factories, create an Element first")
+	}
+	var tree *js.Object
+	Act(func() {
+		tree = testRenderer().Call("create", c.Node())
+	})
+	return &FullTree{Object: tree}
+}
+
+// Act runs f wrapped in react-test-renderer's act, if available, making sure
+// all updates and effects are flushed when it returns.
+func Act(f func()) {
+	act := testRenderer().Get("act")
+	if act == js.Undefined {
+		f()
+		return
+	}
+	act.Invoke(func() { f() })
+}
+
+func testRenderer() *js.Object {
+	renderer, err := support.Require("react-test-renderer")
+	if err != nil {
+		panic(err)
+	}
+	return renderer
+}
+
+// JSON returns the rendered tree as JSON.
+func (t *FullTree) JSON() string {
+	return js.Global.Get("JSON").Call("stringify", t.Call("toJSON")).String()
+}
+
+// Instance returns the this context of the root component.
+func (t *FullTree) Instance() *gr.This {
+	return gr.NewThis(t.Get("root").Get("instance"))
+}
+
+// Update re-renders the tree with the given element.
+func (t *FullTree) Update(c gr.Component) {
+	Act(func() {
+		t.Call("update", c.Node())
+	})
+}
+
+// Unmount unmounts the tree.
+func (t *FullTree) Unmount() {
+	Act(func() {
+		t.Call("unmount")
+	})
+}
+
+// CallEventListener calls the listener with the given name, e.g. onClick, on the
+// first element with the given type.
+func (t *FullTree) CallEventListener(typ, name string, args ...interface{}) {
+	Act(func() {
+		t.Get("root").Call("findByType", typ).Get("props").Call(name, args...)
+	})
+}
+
 // RenderedTree represents a shallow render.
 type RenderedTree struct {
 	*js.Object
@@ -1,4 +1,4 @@
 global.React = require('react');
+global.PropTypes = React.PropTypes || require('prop-types');
 global.ReactElementToString = require('react-element-to-string')
 global.sd = require('skin-deep');
-
@@ -0,0 +1,145 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bep/gr"
+	"github.com/bep/gr/el"
+	"github.com/bep/gr/evt"
+	"github.com/bep/gr/tests/grt"
+)
+
+type testHookCounter struct {
+	Count int
+}
+
+type testHookValue struct {
+	Value interface{}
+}
+
+func TestHooks(t *testing.T) {
+	// Hooks arrived in React 16.8, act in 16.9.
+	grt.RequireReact(t, 16, 9)
+
+	var (
+		effects  int
+		cleanups int
+		renders  int
+	)
+
+	rc := gr.NewFunc(func(props gr.Props, children *gr.Children) gr.Component {
+		counter := gr.UseState(&testHookCounter{Count: 1})
+		clicks := gr.UseState(0)
+		rendersRef := gr.UseRef(0)
+		doubled := gr.UseMemo(func() interface{} {
+			return clicks.Int() * 2
+		}, []interface{}{clicks.Int()})
+
+		rendersRef.SetCurrent(rendersRef.Current().(int) + 1)
+		renders = rendersRef.Current().(int)
+
+		gr.UseEffect(func() func() {
+			effects++
+			return func() {
+				cleanups++
+			}
+		}, []interface{}{})
+
+		c := counter.Value().(*testHookCounter)
+
+		return el.Button(
+			gr.Text(fmt.Sprintf("%d-%d-%d", c.Count, clicks.Int(), doubled.(int))),
+			evt.Click(func(e *gr.Event) {
+				clicks.Update(func(prev interface{}) interface{} {
+					return prev.(int) + 1
+				})
+				counter.Set(&testHookCounter{Count: c.Count + 10})
+			}),
+		)
+	}, gr.DisplayName("HookCounter"))
+
+	tree := grt.FullRender(rc.CreateElement(nil))
+
+	grt.Equal(t, `{"type":"button","props":{},"children":["1-0-0"]}`, tree.JSON())
+
+	tree.CallEventListener("button", "onClick")
+
+	grt.Equal(t, `{"type":"button","props":{},"children":["11-1-2"]}`, tree.JSON())
+	grt.Equal(t, true, renders > 1)
+	grt.Equal(t, 1, effects)
+
+	tree.Unmount()
+
+	grt.Equal(t, 1, cleanups)
+}
+
+func TestUseStateSameValue(t *testing.T) {
+	grt.RequireReact(t, 16, 9)
+
+	renders := 0
+	counter := &testHookCounter{Count: 1}
+
+	rc := gr.NewFunc(func(props gr.Props, children *gr.Children) gr.Component {
+		renders++
+		c := gr.UseState(counter)
+		n := gr.UseState(0)
+
+		return el.Button(
+			gr.Text(fmt.Sprintf("%d-%d", c.Value().(*testHookCounter).Count, n.Int())),
+			evt.Click(func(e *gr.Event) {
+				c.Set(counter)
+				n.Set(0)
+			}),
+		)
+	})
+
+	tree := grt.FullRender(rc.CreateElement(nil))
+	grt.Equal(t, 1, renders)
+
+	tree.CallEventListener("button", "onClick")
+	grt.Equal(t, 1, renders)
+	grt.Equal(t, `{"type":"button","props":{},"children":["1-0"]}`, tree.JSON())
+}
+
+func TestUseStateNotComparable(t *testing.T) {
+	grt.RequireReact(t, 16, 9)
+
+	renders := 0
+
+	rc := gr.NewFunc(func(props gr.Props, children *gr.Children) gr.Component {
+		renders++
+		v := gr.UseState(testHookValue{Value: []int{1}})
+
+		return el.Button(
+			gr.Text(fmt.Sprint(v.Value().(testHookValue).Value)),
+			evt.Click(func(e *gr.Event) {
+				// Comparing these would panic, so this is treated as a new value.
+				v.Set(testHookValue{Value: []int{2}})
+			}),
+		)
+	})
+
+	tree := grt.FullRender(rc.CreateElement(nil))
+	grt.Equal(t, 1, renders)
+
+	tree.CallEventListener("button", "onClick")
+	grt.Equal(t, 2, renders)
+	grt.Equal(t, `{"type":"button","props":{},"children":["[2]"]}`, tree.JSON())
+}
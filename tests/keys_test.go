@@ -0,0 +1,196 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/bep/gr"
+	"github.com/bep/gr/el"
+	"github.com/bep/gr/keys"
+	"github.com/bep/gr/tests/grt"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+func newKeyEvent(target *js.Object, key string, modifiers ...string) js.M {
+	e := js.M{
+		"key":            key,
+		"target":         target,
+		"currentTarget":  target,
+		"nativeEvent":    js.M{},
+		"preventDefault": func() {},
+	}
+	for _, m := range modifiers {
+		e[m+"Key"] = true
+	}
+	return e
+}
+
+func TestKeysBind(t *testing.T) {
+	var (
+		saved, inbox, help int
+		conflicts          []error
+	)
+
+	defaultHandler := keys.ConflictHandler
+	defer func() { keys.ConflictHandler = defaultHandler }()
+	keys.ConflictHandler = func(err error) {
+		conflicts = append(conflicts, err)
+	}
+
+	div := el.Div(
+		gr.Text("Editor"),
+		// mod is ctrl when not on a Mac.
+		keys.Bind("mod+s", func() { saved++ }),
+		keys.Bind("g i", func() { inbox++ }),
+		keys.Bind("?", func() { help++ }),
+	)
+
+	tree := grt.ShallowRender(gr.NewSimpleComponent(div).CreateElement(nil))
+	target := js.Global.Get("Object").New()
+
+	press := func(key string, modifiers ...string) {
+		tree.CallEventListener("onKeyDown", newKeyEvent(target, key, modifiers...))
+	}
+
+	press("s")
+	press("s", "ctrl", "shift")
+	grt.Equal(t, 0, saved)
+	press("s", "ctrl")
+	grt.Equal(t, 1, saved)
+
+	press("g")
+	press("i")
+	press("i")
+	press("g")
+	press("x")
+	press("i")
+	grt.Equal(t, 1, inbox)
+
+	press("?", "shift")
+	grt.Equal(t, 1, help)
+
+	grt.Equal(t, 0, len(conflicts))
+
+	conflicting := el.Div(
+		keys.Bind("ctrl+k", func() {}),
+		keys.Bind("control+K", func() {}),
+	)
+
+	tree = grt.ShallowRender(gr.NewSimpleComponent(conflicting).CreateElement(nil))
+	press("k", "ctrl")
+
+	grt.Equal(t, 1, len(conflicts))
+}
+
+func TestKeysBindSequenceAcrossRenders(t *testing.T) {
+	grt.RequireReact(t, 16, 0)
+
+	var inbox int
+
+	rc := gr.NewFunc(func(props gr.Props, children *gr.Children) gr.Component {
+		return el.Div(
+			gr.Text(props.String("text")),
+			keys.Bind("g i", func() { inbox++ }),
+		)
+	})
+
+	tree := grt.FullRender(rc.CreateElement(gr.Props{"text": "a"}))
+	target := js.Global.Get("Object").New()
+
+	tree.CallEventListener("div", "onKeyDown", newKeyEvent(target, "g"))
+	tree.Update(rc.CreateElement(gr.Props{"text": "b"}))
+	tree.CallEventListener("div", "onKeyDown", newKeyEvent(target, "i"))
+
+	grt.Equal(t, 1, inbox)
+}
+
+func TestKeysBindEditable(t *testing.T) {
+	var saved, help int
+
+	div := el.Div(
+		el.Input(),
+		keys.Bind("mod+s", func() { saved++ }),
+		keys.Bind("?", func() { help++ }),
+	)
+
+	tree := grt.ShallowRender(gr.NewSimpleComponent(div).CreateElement(nil))
+	input := js.Global.Get("Object").New()
+	input.Set("tagName", "INPUT")
+
+	tree.CallEventListener("onKeyDown", newKeyEvent(input, "?", "shift"))
+	grt.Equal(t, 0, help)
+
+	tree.CallEventListener("onKeyDown", newKeyEvent(input, "s", "ctrl"))
+	grt.Equal(t, 1, saved)
+}
+
+func TestKeysBindDocument(t *testing.T) {
+	var listener *js.Object
+
+	document := js.Global.Get("document")
+	defer func() {
+		if document == js.Undefined {
+			js.Global.Delete("document")
+		} else {
+			js.Global.Set("document", document)
+		}
+	}()
+
+	js.Global.Set("document", js.M{
+		"addEventListener": func(typ string, l *js.Object) {
+			listener = l
+		},
+		"removeEventListener": func(typ string, l *js.Object) {
+			listener = nil
+		},
+	})
+
+	var searched int
+
+	unbind, err := keys.BindDocument("g s", func() { searched++ })
+	grt.Equal(t, nil, err)
+	grt.NotNil(t, listener)
+
+	_, err = keys.BindDocument("g", func() {})
+	grt.NotNil(t, err)
+
+	unbindCut, err := keys.BindDocument("mod+x", func() {})
+	grt.Equal(t, nil, err)
+
+	_, err = keys.BindDocument("hyper+x", nil)
+	grt.NotNil(t, err)
+
+	body := js.Global.Get("Object").New()
+	body.Set("tagName", "BODY")
+	input := js.Global.Get("Object").New()
+	input.Set("tagName", "INPUT")
+
+	listener.Invoke(newKeyEvent(body, "g"))
+	listener.Invoke(newKeyEvent(body, "s"))
+	grt.Equal(t, 1, searched)
+
+	// Ignored when typing.
+	listener.Invoke(newKeyEvent(input, "g"))
+	listener.Invoke(newKeyEvent(input, "s"))
+	grt.Equal(t, 1, searched)
+
+	unbind()
+	grt.NotNil(t, listener)
+	unbindCut()
+	grt.Equal(t, true, listener == nil)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/bep/gr"
+	"github.com/bep/gr/el"
+	"github.com/bep/gr/tests/grt"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+type refComp struct {
+	*gr.This
+}
+
+func (c *refComp) Render() gr.Component {
+	return el.Span(gr.Text(c.Props().String("name")))
+}
+
+func TestRefs(t *testing.T) {
+	// forwardRef arrived in React 16.3.
+	grt.RequireReact(t, 16, 3)
+
+	comp := gr.New(&refComp{})
+
+	fancy := gr.NewForwardRef(func(props gr.Props, children *gr.Children, ref *gr.Ref) gr.Component {
+		return comp.CreateElement(gr.Props{"name": "fancy", "ref": ref})
+	}, gr.DisplayName("Fancy"))
+
+	var callbacks []*js.Object
+
+	ref := gr.NewRef()
+	cbRef := gr.NewCallbackRef(func(current *js.Object) {
+		callbacks = append(callbacks, current)
+	})
+
+	grt.Equal(t, true, ref.Current() == nil)
+
+	tree := grt.FullRender(el.Div(
+		fancy.CreateElement(gr.Props{"ref": ref}),
+		comp.CreateElement(gr.Props{"name": "plain", "ref": cbRef}),
+	))
+
+	grt.Equal(t, `{"type":"div","props":{},"children":[`+
+		`{"type":"span","props":{},"children":["fancy"]},`+
+		`{"type":"span","props":{},"children":["plain"]}]}`, tree.JSON())
+
+	// Refs to class components point to the component instance.
+	grt.NotNil(t, ref.Current())
+	grt.Equal(t, "fancy", ref.Current().Get("props").Get("name").String())
+	grt.Equal(t, true, ref.DOM() == nil)
+
+	grt.Equal(t, 1, len(callbacks))
+	grt.Equal(t, "plain", cbRef.Current().Get("props").Get("name").String())
+
+	tree.Unmount()
+
+	grt.Equal(t, true, ref.Current() == nil)
+	grt.Equal(t, true, cbRef.Current() == nil)
+	grt.Equal(t, 2, len(callbacks))
+	grt.Equal(t, true, callbacks[1] == nil)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/bep/gr"
+	"github.com/bep/gr/tests/grt"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// rafStub replaces requestAnimationFrame so the tests can step through the frames.
+type rafStub struct {
+	callbacks []*js.Object
+	restore   func()
+}
+
+func stubRequestAnimationFrame() *rafStub {
+	r := &rafStub{}
+	raf := js.Global.Get("requestAnimationFrame")
+	js.Global.Set("requestAnimationFrame", func(cb *js.Object) {
+		r.callbacks = append(r.callbacks, cb)
+	})
+	r.restore = func() {
+		if raf == js.Undefined {
+			js.Global.Delete("requestAnimationFrame")
+		} else {
+			js.Global.Set("requestAnimationFrame", raf)
+		}
+	}
+	return r
+}
+
+// step runs the pending frames and waits for them to finish, i.e. to request
+// the next frame. It returns the number of frames run.
+func (r *rafStub) step() int {
+	callbacks := r.callbacks
+	r.callbacks = nil
+	for _, cb := range callbacks {
+		cb.Invoke()
+	}
+	// The frames run in goroutines.
+	for i := 0; i < 100 && len(r.callbacks) < len(callbacks); i++ {
+		runtime.Gosched()
+	}
+	return len(callbacks)
+}
+
+func TestScheduler(t *testing.T) {
+	raf := stubRequestAnimationFrame()
+	defer raf.restore()
+
+	renders := 0
+
+	s := gr.NewScheduler(func() {
+		renders++
+	})
+
+	s.Start()
+	defer s.Stop()
+
+	grt.Equal(t, 1, raf.step())
+	grt.Equal(t, 1, raf.step())
+
+	grt.Equal(t, 1, renders)
+	grt.Equal(t, 1, s.Stats().Rendered)
+	grt.Equal(t, 1, s.Stats().Skipped)
+
+	gr.Invalidate()
+	raf.step()
+
+	grt.Equal(t, 2, renders)
+
+	s.Stop()
+	gr.Invalidate()
+	raf.step()
+
+	grt.Equal(t, 2, renders)
+	grt.Equal(t, 0, raf.step())
+
+	// A restart must not leave the old frame loop running.
+	s.Start()
+	s.Stop()
+	s.Start()
+
+	grt.Equal(t, 2, len(raf.callbacks))
+	gr.Invalidate()
+	raf.step()
+	grt.Equal(t, 3, renders)
+	grt.Equal(t, 1, len(raf.callbacks))
+}
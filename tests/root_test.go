@@ -0,0 +1,133 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/bep/gr"
+	"github.com/bep/gr/el"
+	"github.com/bep/gr/support"
+	"github.com/bep/gr/tests/grt"
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// stubRoots replaces the ReactDOM render funcs, which need a real DOM, with stubs
+// that store the rendered element in the container's "rendered" field.
+func stubRoots() (restore func()) {
+	modules := []*js.Object{reactDOMModule()}
+	if client, err := support.Require("react-dom/client"); err == nil {
+		modules = append(modules, client)
+	}
+
+	stubs := map[string]interface{}{
+		"render": func(elem, container *js.Object) {
+			container.Set("rendered", elem)
+		},
+		"unmountComponentAtNode": func(container *js.Object) bool {
+			mounted := container.Get("rendered") != nil
+			container.Set("rendered", nil)
+			return mounted
+		},
+		"createRoot": func(container *js.Object) js.M {
+			return js.M{
+				"render": func(elem *js.Object) {
+					container.Set("rendered", elem)
+				},
+				"unmount": func() {
+					container.Set("rendered", nil)
+				},
+			}
+		},
+	}
+
+	var restores []func()
+	for _, m := range modules {
+		for name, stub := range stubs {
+			m, name, orig := m, name, m.Get(name)
+			if orig == js.Undefined {
+				continue
+			}
+			m.Set(name, stub)
+			restores = append(restores, func() { m.Set(name, orig) })
+		}
+	}
+
+	return func() {
+		for _, f := range restores {
+			f()
+		}
+	}
+}
+
+func rootText(container *js.Object) string {
+	return container.Get("rendered").Get("props").Get("children").String()
+}
+
+func rootsEqual(t *testing.T, expected ...*gr.Root) {
+	roots := gr.Roots()
+	grt.Equal(t, len(expected), len(roots))
+	for i := 0; i < len(expected) && i < len(roots); i++ {
+		grt.Equal(t, expected[i], roots[i])
+	}
+}
+
+func TestRoots(t *testing.T) {
+	defer stubRoots()()
+	defer gr.UnmountAll()
+
+	c1, c2 := js.Global.Get("Object").New(), js.Global.Get("Object").New()
+
+	r1 := gr.NewRoot(c1)
+	grt.Equal(t, r1, gr.NewRoot(c1))
+	grt.Equal(t, c1, r1.Container())
+
+	r2 := gr.NewRoot(c2)
+	rootsEqual(t, r1, r2)
+
+	text := el.Div(gr.Text("static"))
+	r1.Render(text, nil)
+	grt.Equal(t, "static", rootText(c1))
+
+	label := gr.New(&rootLabel{})
+	r2.Render(label, gr.Props{"text": "first"})
+	grt.Equal(t, "first", c2.Get("rendered").Get("props").Get("text").String())
+	r2.Update(gr.Props{"text": "second"})
+	grt.Equal(t, "second", c2.Get("rendered").Get("props").Get("text").String())
+
+	grt.Equal(t, true, r1.Unmount())
+	grt.Equal(t, true, c1.Get("rendered") == nil)
+	rootsEqual(t, r2)
+
+	// An unmounted Root can be rendered into again.
+	r1.Render(text, nil)
+	grt.Equal(t, "static", rootText(c1))
+	rootsEqual(t, r2, r1)
+
+	gr.UnmountAll()
+	rootsEqual(t)
+	grt.Equal(t, true, c1.Get("rendered") == nil)
+	grt.Equal(t, true, c2.Get("rendered") == nil)
+}
+
+type rootLabel struct {
+	*gr.This
+}
+
+func (l *rootLabel) Render() gr.Component {
+	return el.Span(gr.Text(l.Props()["text"]))
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tests
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bep/gr"
+	"github.com/bep/gr/el"
+	"github.com/bep/gr/tests/grt"
+)
+
+func TestRenderToStaticMarkup(t *testing.T) {
+	rc := gr.New(&testTwoButtons{})
+
+	s, err := gr.RenderToStaticMarkup(rc, gr.Props{"b1": "First", "b2": "Second"})
+
+	grt.Equal(t, nil, err)
+	grt.Equal(t, "<div><button>First</button><button>Second</button></div>", s)
+
+	s, err = gr.RenderToStaticMarkup(el.Paragraph(gr.Text("Static")), nil)
+
+	grt.Equal(t, nil, err)
+	grt.Equal(t, "<p>Static</p>", s)
+}
+
+func TestRenderToString(t *testing.T) {
+	rc := gr.New(&testTwoButtons{})
+
+	s, err := gr.RenderToString(rc, gr.Props{"b1": "First", "b2": "Second"})
+
+	grt.Equal(t, nil, err)
+	grt.Equal(t, true, strings.Contains(s, "First"))
+	grt.Equal(t, true, strings.Contains(s, "Second"))
+}
+
+func TestRenderToStringNil(t *testing.T) {
+	_, err := gr.RenderToString(nil, nil)
+
+	grt.NotEqual(t, nil, err)
+}
+
+func TestMarshalProps(t *testing.T) {
+	s, err := gr.MarshalProps(gr.Props{"title": "</script>", "count": 3})
+
+	grt.Equal(t, nil, err)
+	grt.Equal(t, false, strings.Contains(s, "</script>"))
+
+	props, err := gr.UnmarshalProps(s)
+
+	grt.Equal(t, nil, err)
+	grt.Equal(t, "</script>", props["title"])
+	grt.Equal(t, float64(3), props["count"])
+
+	script, err := gr.PropsScript("initial-props", gr.Props{"a": "b"})
+
+	grt.Equal(t, nil, err)
+	grt.Equal(t, `<script type="application/json" id="initial-props">{"a":"b"}</script>`, script)
+}
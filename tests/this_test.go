@@ -20,12 +20,15 @@ import (
 	"testing"
 
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/bep/gr"
 	"github.com/bep/gr/el"
 	"github.com/bep/gr/evt"
 	"github.com/bep/gr/tests/grt"
+	"github.com/gopherjs/gopherjs/js"
 )
 
 func TestPropsFunc(t *testing.T) {
@@ -120,6 +123,316 @@ func TestBindThisVariations(t *testing.T) {
 
 }
 
+func TestPropsAndStateStructs(t *testing.T) {
+	created := time.Date(2017, time.January, 10, 12, 30, 0, 0, time.UTC)
+
+	props, err := gr.PropsFrom(testTypedProps{
+		Title:   "Typed",
+		Count:   3,
+		Created: created,
+		Tags:    []string{"a", "b"},
+		Meta:    map[string]int{"m": 32},
+		Owner:   &testTypedOwner{Name: "Bep"},
+		Skipped: "skip",
+	})
+
+	grt.Equal(t, nil, err)
+
+	_, found := props["Skipped"]
+	grt.Equal(t, false, found)
+
+	comp := gr.New(&thisCompTyped{})
+	tree := grt.ShallowRender(comp.CreateElement(props))
+
+	grt.Equal(t, "<div>Typed-3-2017-2-a,b-32-Bep</div>", tree.String())
+
+	var state testTypedState
+
+	this := tree.This()
+	grt.Equal(t, nil, this.StateInto(&state))
+	grt.Equal(t, 0, state.Clicks)
+
+	grt.Equal(t, nil, this.SetStateFrom(testTypedState{Clicks: 42}))
+	// State updates are applied asynchronously.
+	grt.Flush()
+	grt.Equal(t, nil, this.StateInto(&state))
+	grt.Equal(t, 42, state.Clicks)
+
+	grt.NotEqual(t, nil, this.StateInto(state))
+}
+
+type testTimes struct {
+	Zero   time.Time `gr:"zero"`
+	Old    time.Time `gr:"old"`
+	Future time.Time `gr:"future"`
+}
+
+type thisCompTimes struct {
+	*gr.This
+	times testTimes
+}
+
+func (c *thisCompTimes) Render() gr.Component {
+	if err := c.PropsInto(&c.times); err != nil {
+		panic(err)
+	}
+	return el.Div()
+}
+
+func TestPropsTimes(t *testing.T) {
+	times := testTimes{
+		Old:    time.Date(1500, time.March, 1, 10, 0, 0, 0, time.UTC),
+		Future: time.Date(3000, time.December, 24, 18, 30, 15, 250e6, time.UTC),
+	}
+
+	props, err := gr.PropsFrom(times)
+	grt.Equal(t, nil, err)
+
+	grt.Equal(t, nil, props["zero"])
+	grt.Equal(t, 1500, props["old"].(*js.Object).Call("getUTCFullYear").Int())
+	grt.Equal(t, 3000, props["future"].(*js.Object).Call("getUTCFullYear").Int())
+
+	c := &thisCompTimes{}
+	grt.ShallowRender(gr.New(c).CreateElement(props))
+
+	grt.Equal(t, true, c.times.Zero.IsZero())
+	grt.Equal(t, true, c.times.Old.Equal(times.Old))
+	grt.Equal(t, true, c.times.Future.Equal(times.Future))
+}
+
+func TestSetStateBatching(t *testing.T) {
+	grt.RequireReact(t, 16, 0)
+
+	c := &thisCompRenderCounter{}
+	tree := grt.FullRender(gr.New(c).CreateElement(nil))
+	this := tree.Instance()
+
+	grt.Equal(t, 1, c.renders)
+
+	done := make(chan bool)
+
+	go func() {
+		this.SetState(gr.State{"a": "a1"})
+		this.SetState(gr.State{"b": "b1"})
+		this.SetStateThen(gr.State{"a": "a2"}, func() {
+			go func() { done <- true }()
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("SetStateThen callback not invoked")
+	}
+
+	grt.Equal(t, 2, c.renders)
+	grt.Equal(t, `{"type":"div","props":{},"children":["a2b1"]}`, tree.JSON())
+
+	tree.Unmount()
+
+	// Should be a no-op
+	this.SetState(gr.State{"a": "a3"})
+	grt.Flush()
+
+	grt.Equal(t, 2, c.renders)
+}
+
+func TestRemount(t *testing.T) {
+	c := &thisCompRenderCounter{}
+	tree := grt.FullRender(gr.New(c).CreateElement(nil))
+	defer tree.Unmount()
+	this := tree.Instance()
+
+	ctx := this.Lifetime()
+
+	// Simulate React 18's StrictMode, which unmounts and mounts the same instance.
+	grt.Act(func() {
+		this.This.Call("componentWillUnmount")
+		this.This.Call("componentDidMount")
+	})
+
+	grt.NotNil(t, ctx.Err())
+	grt.Equal(t, nil, this.Lifetime().Err())
+
+	renders := c.renders
+	done := make(chan bool)
+
+	go func() {
+		this.SetStateThen(gr.State{"a": "remounted"}, func() {
+			go func() { done <- true }()
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("SetState ignored after remount")
+	}
+
+	grt.Equal(t, renders+1, c.renders)
+}
+
+func TestUpdateState(t *testing.T) {
+	grt.RequireReact(t, 16, 0)
+
+	c := &thisCompRenderCounter{}
+	tree := grt.FullRender(gr.New(c).CreateElement(gr.Props{"suffix": "y"}))
+	this := tree.Instance()
+
+	done := make(chan bool)
+
+	appendSuffix := func(prev gr.State, props gr.Props) gr.State {
+		return gr.State{"a": prev.String("a") + props.String("suffix")}
+	}
+
+	go func() {
+		this.SetState(gr.State{"a": "x"})
+		this.UpdateState(appendSuffix)
+		this.UpdateState(appendSuffix)
+		this.SetStateThen(gr.State{"b": "b1"}, func() {
+			go func() { done <- true }()
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("SetStateThen callback not invoked")
+	}
+
+	grt.Equal(t, 2, c.renders)
+	grt.Equal(t, `{"type":"div","props":{},"children":["xyyb1"]}`, tree.JSON())
+
+	tree.Unmount()
+}
+
+func TestLifetime(t *testing.T) {
+	c := &thisCompRenderCounter{}
+	tree := grt.FullRender(gr.New(c).CreateElement(nil))
+	this := tree.Instance()
+
+	ctx := this.Lifetime()
+	grt.Equal(t, nil, ctx.Err())
+	grt.Equal(t, ctx, this.Lifetime())
+
+	tree.Unmount()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Lifetime not cancelled on unmount")
+	}
+
+	grt.NotNil(t, this.Lifetime().Err())
+}
+
+func TestListenWindow(t *testing.T) {
+	listeners := make(map[string]*js.Object)
+
+	js.Global.Set("addEventListener", func(name string, l *js.Object) {
+		listeners[name] = l
+	})
+	js.Global.Set("removeEventListener", func(name string, l *js.Object) {
+		if listeners[name] == l {
+			delete(listeners, name)
+		}
+	})
+	defer func() {
+		js.Global.Delete("addEventListener")
+		js.Global.Delete("removeEventListener")
+	}()
+
+	c := &thisCompRenderCounter{}
+	tree := grt.FullRender(gr.New(c).CreateElement(nil))
+	this := tree.Instance()
+
+	var resized, scrolled int
+
+	this.ListenWindow("onResize", func(e *gr.Event) {
+		resized++
+		grt.Equal(t, this.This, e.This.This)
+	})
+	removeScroll := this.ListenWindow("scroll", func(e *gr.Event) {
+		scrolled++
+	})
+	this.ListenWindow("onDoubleClick", func(e *gr.Event) {})
+
+	grt.Equal(t, 3, len(listeners))
+	grt.NotNil(t, listeners["dblclick"])
+
+	listeners["resize"].Invoke(js.M{})
+	listeners["scroll"].Invoke(js.M{})
+
+	grt.Equal(t, 1, resized)
+	grt.Equal(t, 1, scrolled)
+
+	removeScroll()
+	grt.Equal(t, 2, len(listeners))
+
+	tree.Unmount()
+	grt.Equal(t, 0, len(listeners))
+
+	// No-op after unmount.
+	this.ListenWindow("resize", func(e *gr.Event) {})
+	grt.Equal(t, 0, len(listeners))
+}
+
+func TestListenUnknownEvent(t *testing.T) {
+	c := &thisCompRenderCounter{}
+	tree := grt.FullRender(gr.New(c).CreateElement(nil))
+	defer tree.Unmount()
+
+	defer func() {
+		r := recover()
+		grt.NotNil(t, r)
+		grt.Equal(t, `Unknown event "onNoSuchEvent"`, fmt.Sprint(r))
+	}()
+
+	tree.Instance().ListenWindow("onNoSuchEvent", func(e *gr.Event) {})
+}
+
+type thisCompRenderCounter struct {
+	*gr.This
+	renders int
+}
+
+func (c *thisCompRenderCounter) Render() gr.Component {
+	c.renders++
+	return el.Div(gr.Text(c.State().String("a") + c.State().String("b")))
+}
+
+type testTypedOwner struct {
+	Name string `gr:"name"`
+}
+
+type testTypedProps struct {
+	Title   string         `gr:"title"`
+	Count   int            `gr:"count"`
+	Created time.Time      `gr:"created"`
+	Tags    []string       `gr:"tags"`
+	Meta    map[string]int `gr:"meta"`
+	Owner   *testTypedOwner
+	Skipped string `gr:"-"`
+}
+
+type testTypedState struct {
+	Clicks int `gr:"clicks"`
+}
+
+type thisCompTyped struct {
+	*gr.This
+}
+
+func (c *thisCompTyped) Render() gr.Component {
+	var p testTypedProps
+	if err := c.PropsInto(&p); err != nil {
+		panic(err)
+	}
+	s := fmt.Sprintf("%s-%d-%d-%d-%s-%d-%s", p.Title, p.Count, p.Created.Year(), len(p.Tags),
+		strings.Join(p.Tags, ","), p.Meta["m"], p.Owner.Name)
+	return el.Div(gr.Text(s))
+}
+
 type thisCompEmbed struct {
 	*gr.This
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package gr
 
 import (
+	"context"
 	"fmt"
 
 	"reflect"
@@ -42,7 +43,7 @@ type ThisSetter interface {
 }
 
 // Props returns the properties set; this is what you would expect to find in
-// this.props in React.
+// this.props in React. This includes any defaults, see DefaultPropsProvider.
 func (t *This) Props() Props {
 	return objectToMap(t.This.Get("props"))
 }
@@ -105,18 +106,257 @@ func (s State) Interface(key string) interface{} {
 	return nil
 }
 
+// WarnOnSetStateAfterUnmount can be set to get a warning in the console when
+// SetState is called on a component that is unmounted. The call itself is a no-op.
+var WarnOnSetStateAfterUnmount = false
+
+// Properties set on the JavaScript this to track state updates.
+const (
+	unmountedKey        = "__grUnmounted"
+	lifetimeKey         = "__grLifetime"
+	unmountFuncsKey     = "__grUnmountFuncs"
+	pendingStateKey     = "__grPendingState"
+	pendingCallbacksKey = "__grPendingStateCallbacks"
+)
+
 // SetState sets the state with a map of Go interface{} values.
+//
+// It is safe to call SetState from any goroutine, e.g. one started in ComponentDidMount,
+// and calling it after the component is unmounted is a no-op (see WarnOnSetStateAfterUnmount).
+//
+// Note that SetState is always asynchronous, also when called from an event listener:
+// the update is queued and applied after the current tick, with multiple calls within
+// the same tick merged into one React update. The new state is not visible in State
+// until the component has been updated, use SetStateThen to act on that.
 func (t *This) SetState(s State) {
-	t.This.Call("setState", s)
+	t.SetStateThen(s, nil)
+}
+
+// SetStateThen is the same as SetState, but with a func that is invoked when
+// the state update is applied.
+func (t *This) SetStateThen(s State, then func()) {
+	t.enqueueState(s, nil, then)
+}
+
+// UpdateState sets the state using the given updater func, which receives the
+// previous state, including any pending updates, and the current props. The returned
+// State is merged into the state.
+//
+// Use this instead of SetState when the new state depends on the previous, as
+// in a counter, to avoid losing updates. See SetState for the general behaviour.
+//
+// See https://reactjs.org/docs/react-component.html#setstate
+func (t *This) UpdateState(updater func(prev State, props Props) State) {
+	t.enqueueState(nil, updater, nil)
+}
+
+// A pending state update is stored in a queue on the JavaScript this as either
+// a state object or an updater func.
+type stateUpdate struct {
+	*js.Object
+	state   *js.Object `js:"state"`
+	updater *js.Object `js:"updater"`
+}
+
+func (t *This) enqueueState(s State, updater func(prev State, props Props) State, then func()) {
+	if t.This == nil {
+		return
+	}
+
+	if isUnmounted(t.This) {
+		if WarnOnSetStateAfterUnmount {
+			js.Global.Get("console").Call("warn", "gr: SetState called on an unmounted component")
+		}
+		return
+	}
+
+	queue := t.This.Get(pendingStateKey)
+	scheduled := !isNullOrUndefined(queue)
+
+	if !scheduled {
+		queue = js.Global.Get("Array").New()
+		t.This.Set(pendingStateKey, queue)
+		t.This.Set(pendingCallbacksKey, js.Global.Get("Array").New())
+	}
+
+	if updater != nil {
+		u := &stateUpdate{Object: js.Global.Get("Object").New()}
+		u.updater = js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+			var prev, props *js.Object
+			if len(arguments) > 0 {
+				prev = arguments[0]
+			}
+			if len(arguments) > 1 {
+				props = arguments[1]
+			}
+			return updater(objectToMap(prev), objectToMap(props))
+		})
+		queue.Call("push", u)
+	} else if s != nil {
+		// Merge with the previous update if possible.
+		var last *stateUpdate
+		if n := queue.Length(); n > 0 {
+			last = &stateUpdate{Object: queue.Index(n - 1)}
+		}
+		if last == nil || isNullOrUndefined(last.state) {
+			last = &stateUpdate{Object: js.Global.Get("Object").New()}
+			last.state = js.Global.Get("Object").New()
+			queue.Call("push", last)
+		}
+		for k, v := range s {
+			last.state.Set(k, v)
+		}
+	}
+
+	if then != nil {
+		t.This.Get(pendingCallbacksKey).Call("push", then)
+	}
+
+	if !scheduled {
+		that := t.This
+		nextTick(func() {
+			flushState(that)
+		})
+	}
+}
+
+// flushState applies any pending state updates to the component.
+func flushState(that *js.Object) {
+	queue := that.Get(pendingStateKey)
+	if isNullOrUndefined(queue) {
+		return
+	}
+
+	callbacks := that.Get(pendingCallbacksKey)
+	that.Set(pendingStateKey, nil)
+	that.Set(pendingCallbacksKey, nil)
+
+	if isUnmounted(that) {
+		return
+	}
+
+	// Apply the queued updates in order in one setState call, so it results in one render.
+	updater := js.MakeFunc(func(this *js.Object, arguments []*js.Object) interface{} {
+		var prev, props *js.Object
+		if len(arguments) > 0 {
+			prev = arguments[0]
+		}
+		if len(arguments) > 1 {
+			props = arguments[1]
+		}
+
+		assign := js.Global.Get("Object").Get("assign")
+		next := assign.Invoke(js.Global.Get("Object").New(), prev)
+
+		for i := 0; i < queue.Length(); i++ {
+			u := &stateUpdate{Object: queue.Index(i)}
+			if isNullOrUndefined(u.updater) {
+				assign.Invoke(next, u.state)
+			} else {
+				assign.Invoke(next, u.updater.Invoke(next, props))
+			}
+		}
+
+		return next
+	})
+
+	that.Call("setState", updater, func() {
+		for i := 0; i < callbacks.Length(); i++ {
+			callbacks.Index(i).Invoke()
+		}
+	})
+}
+
+// markMounted resets the state set by markUnmounted if the component
+// is mounted again.
+func markMounted(that *js.Object) {
+	if !isUnmounted(that) {
+		return
+	}
+
+	that.Set(unmountedKey, false)
+
+	// The cancelled lifetime is replaced on the next call to Lifetime.
+	that.Delete(lifetimeKey)
+}
+
+func markUnmounted(that *js.Object) {
+	that.Set(unmountedKey, true)
+
+	if l := that.Get(lifetimeKey); !isNullOrUndefined(l) {
+		unwrapGo(l).(*lifetime).cancel()
+	}
+
+	if funcs := that.Get(unmountFuncsKey); !isNullOrUndefined(funcs) {
+		that.Set(unmountFuncsKey, nil)
+		for i := 0; i < funcs.Length(); i++ {
+			funcs.Index(i).Invoke()
+		}
+	}
+}
+
+// onUnmount registers f to be invoked when the component is unmounted.
+func onUnmount(that *js.Object, f func()) {
+	funcs := that.Get(unmountFuncsKey)
+	if isNullOrUndefined(funcs) {
+		funcs = js.Global.Get("Array").New()
+		that.Set(unmountFuncsKey, funcs)
+	}
+	funcs.Call("push", f)
+}
+
+type lifetime struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// Lifetime returns a context.Context that is cancelled when the component is unmounted.
+// Use it to stop goroutines and network requests started in ComponentDidMount etc.:
+//
+//	req = req.WithContext(c.Lifetime())
+//
+// The context is already cancelled if the component is unmounted.
+func (t *This) Lifetime() context.Context {
+	if l := t.This.Get(lifetimeKey); !isNullOrUndefined(l) {
+		return unwrapGo(l).(*lifetime).ctx
+	}
+
+	l := &lifetime{}
+	l.ctx, l.cancel = context.WithCancel(context.Background())
+
+	if isUnmounted(t.This) {
+		l.cancel()
+	}
+
+	t.This.Set(lifetimeKey, wrapGo(l))
+
+	return l.ctx
+}
+
+func isUnmounted(that *js.Object) bool {
+	return that.Get(unmountedKey).Bool()
+}
+
+// nextTick runs f as soon as possible after the current tick.
+func nextTick(f func()) {
+	if promise := js.Global.Get("Promise"); promise != js.Undefined {
+		promise.Call("resolve").Call("then", f)
+		return
+	}
+	js.Global.Call("setTimeout", f, 0)
 }
 
 // Refs returns the component references.
 // See https://facebook.github.io/react/docs/more-about-refs.html
+//
+// Deprecated: String refs are deprecated in React, use NewRef.
 func (t *This) Refs() Refs {
 	return objectToMap(t.This.Get("refs"))
 }
 
 // GetDOMNode returns the component from refs if it has been mounted into the DOM.
+//
+// Deprecated: This uses findDOMNode, which is deprecated in React, use NewRef and Ref.DOM.
 func (r Refs) GetDOMNode(key string) *js.Object {
 	if o, ok := r[key]; ok {
 		return reactDOM.Call("findDOMNode", o)
@@ -125,7 +365,9 @@ func (r Refs) GetDOMNode(key string) *js.Object {
 }
 
 // ForceUpdate forces a re-render of the component.
+// Any pending state updates are applied first.
 func (t *This) ForceUpdate() {
+	flushState(t.This)
 	t.This.Call("forceUpdate")
 }
 
@@ -211,7 +453,15 @@ type Children struct {
 
 // Children returns this component's children, if any.
 func (t *This) Children() *Children {
-	o := t.This.Get("props").Get("children")
+	return childrenFromProps(t.This.Get("props"))
+}
+
+func childrenFromProps(props *js.Object) *Children {
+	if isNullOrUndefined(props) {
+		return nil
+	}
+
+	o := props.Get("children")
 
 	if o == js.Undefined {
 		return nil
@@ -250,8 +500,18 @@ func hasChanged(m1, m2 map[string]interface{}, keys ...string) bool {
 // HasChangedDeeply reports whether the value of the state with any of the given keys has changed.
 // This uses reflect.DeepEqual. For shallow equality checking, see HasChanged.
 func (s State) HasChangedDeeply(nextState State, keys ...string) bool {
+	return hasChangedDeeply(s, nextState, keys...)
+}
+
+// HasChangedDeeply reports whether the value of the property with any of the given keys has changed.
+// This uses reflect.DeepEqual. For shallow equality checking, see HasChanged.
+func (p Props) HasChangedDeeply(nextProps Props, keys ...string) bool {
+	return hasChangedDeeply(p, nextProps, keys...)
+}
+
+func hasChangedDeeply(m1, m2 map[string]interface{}, keys ...string) bool {
 	for _, key := range keys {
-		if !reflect.DeepEqual(s[key], nextState[key]) {
+		if !reflect.DeepEqual(m1[key], m2[key]) {
 			return true
 		}
 	}
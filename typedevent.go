@@ -0,0 +1,343 @@
+/*
+Copyright 2016 Bjørn Erik Pedersen <bjorn.erik.pedersen@gmail.com> All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gr
+
+import (
+	"github.com/gopherjs/gopherjs/js"
+)
+
+// Typed views of the React synthetic events, see the listeners with a type suffix
+// in the evt package, e.g. evt.ClickM.
+//
+// See https://reactjs.org/docs/events.html
+
+// MouseEvent is a mouse event, e.g. a click.
+type MouseEvent struct {
+	*Event
+}
+
+// MouseListener adapts a func taking a MouseEvent to a Listener.
+func MouseListener(f func(*MouseEvent)) Listener {
+	return func(e *Event) {
+		f(&MouseEvent{Event: e})
+	}
+}
+
+// ClientX returns the horizontal coordinate within the viewport.
+func (e *MouseEvent) ClientX() int {
+	return e.Get("clientX").Int()
+}
+
+// ClientY returns the vertical coordinate within the viewport.
+func (e *MouseEvent) ClientY() int {
+	return e.Get("clientY").Int()
+}
+
+// PageX returns the horizontal coordinate relative to the whole document.
+func (e *MouseEvent) PageX() int {
+	return e.Get("pageX").Int()
+}
+
+// PageY returns the vertical coordinate relative to the whole document.
+func (e *MouseEvent) PageY() int {
+	return e.Get("pageY").Int()
+}
+
+// ScreenX returns the horizontal coordinate relative to the screen.
+func (e *MouseEvent) ScreenX() int {
+	return e.Get("screenX").Int()
+}
+
+// ScreenY returns the vertical coordinate relative to the screen.
+func (e *MouseEvent) ScreenY() int {
+	return e.Get("screenY").Int()
+}
+
+// Button returns the button that changed state: 0 is the main button, 1 the
+// auxiliary (middle) button and 2 the secondary button.
+func (e *MouseEvent) Button() int {
+	return e.Get("button").Int()
+}
+
+// Buttons returns the buttons held down as a bit mask: 1 is the main button, 2 the
+// secondary button and 4 the auxiliary (middle) button.
+func (e *MouseEvent) Buttons() int {
+	return e.Get("buttons").Int()
+}
+
+// RelatedTarget returns the secondary target, e.g. the element entered on mouseleave.
+func (e *MouseEvent) RelatedTarget() *js.Object {
+	return e.Get("relatedTarget")
+}
+
+// AltKey reports whether the Alt key was down.
+func (e *MouseEvent) AltKey() bool {
+	return e.Get("altKey").Bool()
+}
+
+// CtrlKey reports whether the Control key was down.
+func (e *MouseEvent) CtrlKey() bool {
+	return e.Get("ctrlKey").Bool()
+}
+
+// MetaKey reports whether the Meta key, e.g. ⌘ on a Mac, was down.
+func (e *MouseEvent) MetaKey() bool {
+	return e.Get("metaKey").Bool()
+}
+
+// ShiftKey reports whether the Shift key was down.
+func (e *MouseEvent) ShiftKey() bool {
+	return e.Get("shiftKey").Bool()
+}
+
+// KeyboardEvent is a keyboard event, e.g. a key down.
+type KeyboardEvent struct {
+	*Event
+}
+
+// KeyboardListener adapts a func taking a KeyboardEvent to a Listener.
+func KeyboardListener(f func(*KeyboardEvent)) Listener {
+	return func(e *Event) {
+		f(&KeyboardEvent{Event: e})
+	}
+}
+
+// Key returns the value of the key, e.g. "a", "A" or "Enter", taking the keyboard
+// layout and the modifier keys into account.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/KeyboardEvent/key/Key_Values
+func (e *KeyboardEvent) Key() string {
+	return e.Get("key").String()
+}
+
+// Code returns the physical key, e.g. "KeyA", regardless of the keyboard layout.
+func (e *KeyboardEvent) Code() string {
+	code := e.Get("code")
+	if code == js.Undefined {
+		// Not in React's synthetic event before React 17.
+		code = e.Get("nativeEvent").Get("code")
+	}
+	if isNullOrUndefined(code) {
+		return ""
+	}
+	return code.String()
+}
+
+// Repeat reports whether the key is held down so it is auto repeating.
+func (e *KeyboardEvent) Repeat() bool {
+	return e.Get("repeat").Bool()
+}
+
+// AltKey reports whether the Alt key was down.
+func (e *KeyboardEvent) AltKey() bool {
+	return e.Get("altKey").Bool()
+}
+
+// CtrlKey reports whether the Control key was down.
+func (e *KeyboardEvent) CtrlKey() bool {
+	return e.Get("ctrlKey").Bool()
+}
+
+// MetaKey reports whether the Meta key, e.g. ⌘ on a Mac, was down.
+func (e *KeyboardEvent) MetaKey() bool {
+	return e.Get("metaKey").Bool()
+}
+
+// ShiftKey reports whether the Shift key was down.
+func (e *KeyboardEvent) ShiftKey() bool {
+	return e.Get("shiftKey").Bool()
+}
+
+// Touch is a single point of contact in a TouchEvent.
+type Touch struct {
+	*js.Object
+}
+
+// Identifier returns an identifier for the touch point, unique for as long as it lasts.
+func (t *Touch) Identifier() int {
+	return t.Get("identifier").Int()
+}
+
+// ClientX returns the horizontal coordinate within the viewport.
+func (t *Touch) ClientX() int {
+	return t.Get("clientX").Int()
+}
+
+// ClientY returns the vertical coordinate within the viewport.
+func (t *Touch) ClientY() int {
+	return t.Get("clientY").Int()
+}
+
+// PageX returns the horizontal coordinate relative to the whole document.
+func (t *Touch) PageX() int {
+	return t.Get("pageX").Int()
+}
+
+// PageY returns the vertical coordinate relative to the whole document.
+func (t *Touch) PageY() int {
+	return t.Get("pageY").Int()
+}
+
+// Target returns the element on which the touch point started.
+func (t *Touch) Target() *js.Object {
+	return t.Get("target")
+}
+
+// TouchEvent is a touch event, e.g. a touch start.
+type TouchEvent struct {
+	*Event
+}
+
+// TouchListener adapts a func taking a TouchEvent to a Listener.
+func TouchListener(f func(*TouchEvent)) Listener {
+	return func(e *Event) {
+		f(&TouchEvent{Event: e})
+	}
+}
+
+// Touches returns all the current touch points.
+func (e *TouchEvent) Touches() []*Touch {
+	return touchList(e.Get("touches"))
+}
+
+// TargetTouches returns the touch points that started on the target element.
+func (e *TouchEvent) TargetTouches() []*Touch {
+	return touchList(e.Get("targetTouches"))
+}
+
+// ChangedTouches returns the touch points that changed in this event.
+func (e *TouchEvent) ChangedTouches() []*Touch {
+	return touchList(e.Get("changedTouches"))
+}
+
+// AltKey reports whether the Alt key was down.
+func (e *TouchEvent) AltKey() bool {
+	return e.Get("altKey").Bool()
+}
+
+// CtrlKey reports whether the Control key was down.
+func (e *TouchEvent) CtrlKey() bool {
+	return e.Get("ctrlKey").Bool()
+}
+
+// MetaKey reports whether the Meta key, e.g. ⌘ on a Mac, was down.
+func (e *TouchEvent) MetaKey() bool {
+	return e.Get("metaKey").Bool()
+}
+
+// ShiftKey reports whether the Shift key was down.
+func (e *TouchEvent) ShiftKey() bool {
+	return e.Get("shiftKey").Bool()
+}
+
+func touchList(o *js.Object) []*Touch {
+	if isNullOrUndefined(o) {
+		return nil
+	}
+	touches := make([]*Touch, o.Length())
+	for i := range touches {
+		touches[i] = &Touch{Object: o.Index(i)}
+	}
+	return touches
+}
+
+// WheelEvent is a mouse wheel or similar event.
+type WheelEvent struct {
+	*MouseEvent
+}
+
+// WheelListener adapts a func taking a WheelEvent to a Listener.
+func WheelListener(f func(*WheelEvent)) Listener {
+	return func(e *Event) {
+		f(&WheelEvent{MouseEvent: &MouseEvent{Event: e}})
+	}
+}
+
+// DeltaX returns the horizontal scroll amount, see DeltaMode.
+func (e *WheelEvent) DeltaX() float64 {
+	return e.Get("deltaX").Float()
+}
+
+// DeltaY returns the vertical scroll amount, see DeltaMode.
+func (e *WheelEvent) DeltaY() float64 {
+	return e.Get("deltaY").Float()
+}
+
+// DeltaZ returns the scroll amount for the z-axis, see DeltaMode.
+func (e *WheelEvent) DeltaZ() float64 {
+	return e.Get("deltaZ").Float()
+}
+
+// DeltaMode returns the unit of the delta values: 0 is pixels, 1 lines and 2 pages.
+func (e *WheelEvent) DeltaMode() int {
+	return e.Get("deltaMode").Int()
+}
+
+// ClipboardEvent is a clipboard event, e.g. a paste.
+type ClipboardEvent struct {
+	*Event
+}
+
+// ClipboardListener adapts a func taking a ClipboardEvent to a Listener.
+func ClipboardListener(f func(*ClipboardEvent)) Listener {
+	return func(e *Event) {
+		f(&ClipboardEvent{Event: e})
+	}
+}
+
+// ClipboardData returns the DataTransfer with the data affected by the clipboard operation.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/DataTransfer
+func (e *ClipboardEvent) ClipboardData() *js.Object {
+	return e.Get("clipboardData")
+}
+
+// FocusEvent is a focus event, e.g. a blur.
+type FocusEvent struct {
+	*Event
+}
+
+// FocusListener adapts a func taking a FocusEvent to a Listener.
+func FocusListener(f func(*FocusEvent)) Listener {
+	return func(e *Event) {
+		f(&FocusEvent{Event: e})
+	}
+}
+
+// RelatedTarget returns the secondary target, e.g. the element losing focus on focus.
+func (e *FocusEvent) RelatedTarget() *js.Object {
+	return e.Get("relatedTarget")
+}
+
+// DragEvent is a drag and drop event.
+type DragEvent struct {
+	*MouseEvent
+}
+
+// DragListener adapts a func taking a DragEvent to a Listener.
+func DragListener(f func(*DragEvent)) Listener {
+	return func(e *Event) {
+		f(&DragEvent{MouseEvent: &MouseEvent{Event: e}})
+	}
+}
+
+// DataTransfer returns the DataTransfer with the data being dragged.
+//
+// See https://developer.mozilla.org/en-US/docs/Web/API/DataTransfer
+func (e *DragEvent) DataTransfer() *js.Object {
+	return e.Get("dataTransfer")
+}